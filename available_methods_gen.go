@@ -52,7 +52,7 @@ type SendMessageRequest struct {
 
 	// Optional. Mode for parsing entities in the message text. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in message text, which can be
 	// specified instead of parse_mode
@@ -139,7 +139,7 @@ type CopyMessageRequest struct {
 
 	// Optional. Mode for parsing entities in the new caption. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the new caption, which can be
 	// specified instead of parse_mode
@@ -196,7 +196,7 @@ type SendPhotoRequest struct {
 
 	// Optional. Mode for parsing entities in the photo caption. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -247,7 +247,7 @@ type SendAudioRequest struct {
 
 	// Optional. Mode for parsing entities in the audio caption. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -331,7 +331,7 @@ type SendDocumentRequest struct {
 
 	// Optional. Mode for parsing entities in the document caption. See formatting
 	// options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -407,7 +407,7 @@ type SendVideoRequest struct {
 
 	// Optional. Mode for parsing entities in the video caption. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -483,7 +483,7 @@ type SendAnimationRequest struct {
 
 	// Optional. Mode for parsing entities in the animation caption. See formatting
 	// options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -537,7 +537,7 @@ type SendVoiceRequest struct {
 
 	// Optional. Mode for parsing entities in the voice message caption. See formatting
 	// options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -898,10 +898,10 @@ type SendPollRequest struct {
 	Options []string `json:"options"`
 
 	// Optional. True, if the poll needs to be anonymous, defaults to True
-	IsAnonymous bool `json:"is_anonymous,omitempty"`
+	IsAnonymous *bool `json:"is_anonymous,omitempty"`
 
 	// Optional. Poll type, “quiz” or “regular”, defaults to “regular”
-	Type string `json:"type,omitempty"`
+	Type PollType `json:"type,omitempty"`
 
 	// Optional. True, if the poll allows multiple answers, ignored for polls in quiz
 	// mode, defaults to False
@@ -909,7 +909,7 @@ type SendPollRequest struct {
 
 	// Optional. 0-based identifier of the correct answer option, required for polls in
 	// quiz mode
-	CorrectOptionID int `json:"correct_option_id,omitempty"`
+	CorrectOptionID *int `json:"correct_option_id,omitempty"`
 
 	// Optional. Text that is shown when a user chooses an incorrect answer or taps on
 	// the lamp icon in a quiz-style poll, 0-200 characters with at most 2 line feeds
@@ -918,7 +918,7 @@ type SendPollRequest struct {
 
 	// Optional. Mode for parsing entities in the explanation. See formatting options
 	// for more details.
-	ExplanationParseMode string `json:"explanation_parse_mode,omitempty"`
+	ExplanationParseMode ParseMode `json:"explanation_parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the poll explanation, which
 	// can be specified instead of parse_mode
@@ -973,10 +973,11 @@ type SendDiceRequest struct {
 	ChatID string `json:"chat_id"`
 
 	// Optional. Emoji on which the dice throw animation is based. Currently, must be
-	// one of “”, “”, “”, “”, “”, or “”. Dice can have values
-	// 1-6 for “”, “” and “”, values 1-5 for “” and “”, and values
-	// 1-64 for “”. Defaults to “”
-	Emoji string `json:"emoji,omitempty"`
+	// one of “🎲”, “🎯”, “🏀”, “⚽”, “🎳”, or “🎰”.
+	// Dice can have values 1-6 for “🎲”, “🎯” and “🎳”, values 1-5
+	// for “🏀” and “⚽”, and values 1-64 for “🎰”. Defaults to
+	// “🎲”
+	Emoji DiceEmoji `json:"emoji,omitempty"`
 
 	// Optional. Sends the message silently. Users will receive a notification with no
 	// sound.
@@ -1018,7 +1019,7 @@ type SendChatActionRequest struct {
 	// upload_video for videos, record_voice or upload_voice for voice notes,
 	// upload_document for general files, find_location for location data,
 	// record_video_note or upload_video_note for video notes.
-	Action string `json:"action"`
+	Action ChatAction `json:"action"`
 }
 
 // Use this method when you need to tell the user that something is happening on
@@ -1026,13 +1027,11 @@ type SendChatActionRequest struct {
 // from your bot, Telegram clients clear its typing status). Returns True on
 // success.
 //
-//
 // Example: The ImageBot needs some time to process a request and upload the image.
 // Instead of sending a text message along the lines of “Retrieving image, please
 // wait…”, the bot may use sendChatAction with action = upload_photo. The user
 // will see a “sending photo” status for the bot.
 //
-//
 // We only recommend using this method when a response from the bot will take a
 // noticeable amount of time to arrive.
 func (b *Bot) SendChatAction(req *SendChatActionRequest) (json.RawMessage, error) {
@@ -1273,14 +1272,12 @@ type ExportChatInviteLinkRequest struct {
 // for this to work and must have the appropriate admin rights. Returns the new
 // invite link as String on success.
 //
-//
 // Note: Each administrator in a chat generates their own invite links. Bots can't
 // use invite links generated by other administrators. If you want your bot to work
 // with invite links, it will need to generate its own link using
 // exportChatInviteLink or by calling the getChat method. If your bot needs to
 // generate a new primary invite link replacing its previous one, use
 // exportChatInviteLink again.
-//
 func (b *Bot) ExportChatInviteLink(req *ExportChatInviteLinkRequest) (json.RawMessage, error) {
 	return b.makeRequest("exportChatInviteLink", req)
 }
@@ -1632,12 +1629,10 @@ type AnswerCallbackQueryRequest struct {
 // The answer will be displayed to the user as a notification at the top of the
 // chat screen or as an alert. On success, True is returned.
 //
-//
 // Alternatively, the user can be redirected to the specified Game URL. For this
 // option to work, you must first create a game for your bot via @Botfather and
 // accept the terms. Otherwise, you may use links like t.me/your_bot?start=XXXX
 // that open your bot with a parameter.
-//
 func (b *Bot) AnswerCallbackQuery(req *AnswerCallbackQueryRequest) (json.RawMessage, error) {
 	return b.makeRequest("answerCallbackQuery", req)
 }
@@ -1658,13 +1653,13 @@ type GetMyCommandsRequest struct{}
 
 // Use this method to get the current list of the bot's commands. Requires no
 // parameters. Returns Array of BotCommand on success.
-func (b *Bot) GetMyCommands(req *GetMyCommandsRequest) (*BotCommand, error) {
+func (b *Bot) GetMyCommands(req *GetMyCommandsRequest) (*[]BotCommand, error) {
 	j, err := b.makeRequest("getMyCommands", req)
 	if err != nil {
 		return nil, err
 	}
 
-	var resp BotCommand
+	var resp []BotCommand
 	err = json.Unmarshal(j, &resp)
 	return &resp, err
 }
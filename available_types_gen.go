@@ -46,7 +46,7 @@ type Chat struct {
 
 	// Type of chat, can be either “private”, “group”, “supergroup” or
 	// “channel”
-	Type string `json:"type"`
+	Type ChatType `json:"type"`
 
 	// Optional. Title, for supergroups, channels and group chats
 	Title string `json:"title,omitempty"`
@@ -339,7 +339,7 @@ type MessageEntity struct {
 	// (strikethrough text), “code” (monowidth string), “pre” (monowidth
 	// block), “text_link” (for clickable text URLs), “text_mention” (for users
 	// without usernames)
-	Type string `json:"type"`
+	Type MessageEntityType `json:"type"`
 
 	// Offset in UTF-16 code units to the start of the entity
 	Offset int `json:"offset"`
@@ -561,10 +561,10 @@ type Contact struct {
 // This object represents an animated emoji that displays a random value.
 type Dice struct {
 	// Emoji on which the dice throw animation is based
-	Emoji string `json:"emoji"`
+	Emoji DiceEmoji `json:"emoji"`
 
-	// Value of the dice, 1-6 for “”, “” and “” base emoji, 1-5 for “”
-	// and “” base emoji, 1-64 for “” base emoji
+	// Value of the dice, 1-6 for “🎲”, “🎯” and “🎳” base emoji, 1-5
+	// for “🏀” and “⚽” base emoji, 1-64 for “🎰” base emoji
 	Value int `json:"value"`
 }
 
@@ -611,7 +611,7 @@ type Poll struct {
 	IsAnonymous bool `json:"is_anonymous"`
 
 	// Poll type, currently can be “regular” or “quiz”
-	Type string `json:"type"`
+	Type PollType `json:"type"`
 
 	// True, if the poll allows multiple answers
 	AllowsMultipleAnswers bool `json:"allows_multiple_answers"`
@@ -619,7 +619,7 @@ type Poll struct {
 	// Optional. 0-based identifier of the correct answer option. Available only for
 	// polls in the quiz mode, which are closed, or was sent (not forwarded) by the bot
 	// or to the private chat with the bot.
-	CorrectOptionID int `json:"correct_option_id,omitempty"`
+	CorrectOptionID *int `json:"correct_option_id,omitempty"`
 
 	// Optional. Text that is shown when a user chooses an incorrect answer or taps on
 	// the lamp icon in a quiz-style poll, 0-200 characters
@@ -747,9 +747,7 @@ type UserProfilePhotos struct {
 // guaranteed that the link will be valid for at least 1 hour. When the link
 // expires, a new one can be requested by calling getFile.
 //
-//
 // Maximum file size to download is 20 MB
-//
 type File struct {
 	// Identifier for this file, which can be used to download or reuse the file
 	FileID string `json:"file_id"`
@@ -829,7 +827,7 @@ type KeyboardButtonPollType struct {
 	// Optional. If quiz is passed, the user will be allowed to create only polls in
 	// the quiz mode. If regular is passed, only regular polls will be allowed.
 	// Otherwise, the user will be allowed to create a poll of any type.
-	Type string `json:"type,omitempty"`
+	Type *string `json:"type,omitempty"`
 }
 
 // Upon receiving a message with this object, Telegram clients will remove the
@@ -921,9 +919,7 @@ type InlineKeyboardButton struct {
 //
 // Telegram apps support these buttons as of version 5.7.
 //
-//
 // Sample bot: @discussbot
-//
 type LoginURL struct {
 	// An HTTP URL to be opened with user authorization data added to the query string
 	// when the button is pressed. If the user refuses to provide authorization data,
@@ -956,12 +952,10 @@ type LoginURL struct {
 // inline_message_id will be present. Exactly one of the fields data or
 // game_short_name will be present.
 //
-//
 // NOTE: After the user presses a callback button, Telegram clients will display a
 // progress bar until you call answerCallbackQuery. It is, therefore, necessary to
 // react by calling answerCallbackQuery even if no notification to the user is
 // needed (e.g., without specifying any of the optional parameters).
-//
 type CallbackQuery struct {
 	// Unique identifier for this query
 	ID string `json:"id"`
@@ -996,26 +990,21 @@ type CallbackQuery struct {
 // tapped 'Reply'). This can be extremely useful if you want to create
 // user-friendly step-by-step interfaces without having to sacrifice privacy mode.
 //
-//
 // Example: A poll bot for groups runs in privacy mode (only receives commands,
 // replies to its messages and mentions). There could be two ways to create a new
 // poll:
 //
-//
 // - Explain the user how to send a command with parameters (e.g. /newpoll question
 // answer1 answer2). May be appealing for hardcore users but lacks modern day
 // polish.
 //
-//
 // - Guide the user through a step-by-step process. 'Please send me your question',
 // 'Cool, now let's add the first answer option', 'Great. Keep adding answer
 // options, then send /done when you're ready'.
 //
-//
 // The last option is definitely more attractive. And if you use ForceReply in your
 // bot's questions, it will receive the user's answers even if it only receives
 // replies, commands and mentions — without any extra work for the user.
-//
 type ForceReply struct {
 	// Shows reply interface to the user, as if they manually selected the bot's
 	// message and tapped 'Reply'
@@ -1080,7 +1069,7 @@ type ChatMember struct {
 
 	// The member's status in the chat. Can be “creator”, “administrator”,
 	// “member”, “restricted”, “left” or “kicked”
-	Status string `json:"status"`
+	Status ChatMemberStatus `json:"status"`
 
 	// Optional. Owner and administrators only. Custom title for this user
 	CustomTitle string `json:"custom_title,omitempty"`
@@ -1191,33 +1180,33 @@ type ChatMemberUpdated struct {
 type ChatPermissions struct {
 	// Optional. True, if the user is allowed to send text messages, contacts,
 	// locations and venues
-	CanSendMessages bool `json:"can_send_messages,omitempty"`
+	CanSendMessages *bool `json:"can_send_messages,omitempty"`
 
 	// Optional. True, if the user is allowed to send audios, documents, photos,
 	// videos, video notes and voice notes, implies can_send_messages
-	CanSendMediaMessages bool `json:"can_send_media_messages,omitempty"`
+	CanSendMediaMessages *bool `json:"can_send_media_messages,omitempty"`
 
 	// Optional. True, if the user is allowed to send polls, implies can_send_messages
-	CanSendPolls bool `json:"can_send_polls,omitempty"`
+	CanSendPolls *bool `json:"can_send_polls,omitempty"`
 
 	// Optional. True, if the user is allowed to send animations, games, stickers and
 	// use inline bots, implies can_send_media_messages
-	CanSendOtherMessages bool `json:"can_send_other_messages,omitempty"`
+	CanSendOtherMessages *bool `json:"can_send_other_messages,omitempty"`
 
 	// Optional. True, if the user is allowed to add web page previews to their
 	// messages, implies can_send_media_messages
-	CanAddWebPagePreviews bool `json:"can_add_web_page_previews,omitempty"`
+	CanAddWebPagePreviews *bool `json:"can_add_web_page_previews,omitempty"`
 
 	// Optional. True, if the user is allowed to change the chat title, photo and other
 	// settings. Ignored in public supergroups
-	CanChangeInfo bool `json:"can_change_info,omitempty"`
+	CanChangeInfo *bool `json:"can_change_info,omitempty"`
 
 	// Optional. True, if the user is allowed to invite new users to the chat
-	CanInviteUsers bool `json:"can_invite_users,omitempty"`
+	CanInviteUsers *bool `json:"can_invite_users,omitempty"`
 
 	// Optional. True, if the user is allowed to pin messages. Ignored in public
 	// supergroups
-	CanPinMessages bool `json:"can_pin_messages,omitempty"`
+	CanPinMessages *bool `json:"can_pin_messages,omitempty"`
 }
 
 // Represents a location to which a chat is connected.
@@ -1256,23 +1245,15 @@ type ResponseParameters struct {
 // This object represents the content of a media message to be sent. It should be
 // one of
 //
-//
-//
 // - InputMediaAnimation
 //
-//
 // - InputMediaDocument
 //
-//
 // - InputMediaAudio
 //
-//
 // - InputMediaPhoto
 //
-//
 // - InputMediaVideo
-//
-//
 type InputMedia struct{}
 
 // Represents a photo to be sent.
@@ -1293,7 +1274,7 @@ type InputMediaPhoto struct {
 
 	// Optional. Mode for parsing entities in the photo caption. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -1327,7 +1308,7 @@ type InputMediaVideo struct {
 
 	// Optional. Mode for parsing entities in the video caption. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -1374,7 +1355,7 @@ type InputMediaAnimation struct {
 
 	// Optional. Mode for parsing entities in the animation caption. See formatting
 	// options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -1417,7 +1398,7 @@ type InputMediaAudio struct {
 
 	// Optional. Mode for parsing entities in the audio caption. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -1460,7 +1441,7 @@ type InputMediaDocument struct {
 
 	// Optional. Mode for parsing entities in the document caption. See formatting
 	// options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
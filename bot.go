@@ -1,6 +1,7 @@
 package telegram
 
 import (
+	"encoding/json"
 	"net/http"
 )
 
@@ -37,3 +38,14 @@ func NewBotWithOpts(token string, opts *Opts) *Bot {
 
 	return b
 }
+
+// Client returns the http client, which is used for requests to the Bot API.
+func (b *Bot) Client() *http.Client {
+	return b.client
+}
+
+// MakeRequest calls the method with the request, which is marshaled to json.
+// Can be used for methods and parameters, which are not generated yet.
+func (b *Bot) MakeRequest(methodName string, req interface{}) (json.RawMessage, error) {
+	return b.makeRequest(methodName, req)
+}
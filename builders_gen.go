@@ -0,0 +1,2477 @@
+// Code generated by telegram-apigen. DO NOT EDIT.
+
+package telegram
+
+// NewGetMe creates GetMeRequest with the required parameters.
+func NewGetMe() *GetMeRequest {
+	return &GetMeRequest{}
+}
+
+// NewLogOut creates LogOutRequest with the required parameters.
+func NewLogOut() *LogOutRequest {
+	return &LogOutRequest{}
+}
+
+// NewClose creates CloseRequest with the required parameters.
+func NewClose() *CloseRequest {
+	return &CloseRequest{}
+}
+
+// NewMessage creates SendMessageRequest with the required parameters.
+func NewMessage(chatID string, text string) *SendMessageRequest {
+	return &SendMessageRequest{
+		ChatID: chatID,
+		Text:   text,
+	}
+}
+
+// WithParseMode sets optional parameter parse_mode.
+func (r *SendMessageRequest) WithParseMode(v ParseMode) *SendMessageRequest {
+	r.ParseMode = v
+	return r
+}
+
+// WithEntities sets optional parameter entities.
+func (r *SendMessageRequest) WithEntities(v []MessageEntity) *SendMessageRequest {
+	r.Entities = v
+	return r
+}
+
+// WithDisableWebPagePreview sets optional parameter disable_web_page_preview.
+func (r *SendMessageRequest) WithDisableWebPagePreview(v bool) *SendMessageRequest {
+	r.DisableWebPagePreview = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendMessageRequest) WithDisableNotification(v bool) *SendMessageRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendMessageRequest) WithReplyToMessageID(v int) *SendMessageRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendMessageRequest) WithAllowSendingWithoutReply(v bool) *SendMessageRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendMessageRequest) WithReplyMarkup(v AnyKeyboard) *SendMessageRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// HTML sets parse_mode to ParseModeHTML.
+func (r *SendMessageRequest) HTML() *SendMessageRequest {
+	r.ParseMode = ParseModeHTML
+	return r
+}
+
+// MarkdownV2 sets parse_mode to ParseModeMarkdownV2.
+func (r *SendMessageRequest) MarkdownV2() *SendMessageRequest {
+	r.ParseMode = ParseModeMarkdownV2
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendMessageRequest) Silent() *SendMessageRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendMessageRequest) ReplyTo(v int) *SendMessageRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendMessageRequest) Keyboard(v AnyKeyboard) *SendMessageRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewForwardMessage creates ForwardMessageRequest with the required parameters.
+func NewForwardMessage(chatID string, fromChatID string, messageID int) *ForwardMessageRequest {
+	return &ForwardMessageRequest{
+		ChatID:     chatID,
+		FromChatID: fromChatID,
+		MessageID:  messageID,
+	}
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *ForwardMessageRequest) WithDisableNotification(v bool) *ForwardMessageRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *ForwardMessageRequest) Silent() *ForwardMessageRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// NewCopyMessage creates CopyMessageRequest with the required parameters.
+func NewCopyMessage(chatID string, fromChatID string, messageID int) *CopyMessageRequest {
+	return &CopyMessageRequest{
+		ChatID:     chatID,
+		FromChatID: fromChatID,
+		MessageID:  messageID,
+	}
+}
+
+// WithCaption sets optional parameter caption.
+func (r *CopyMessageRequest) WithCaption(v string) *CopyMessageRequest {
+	r.Caption = v
+	return r
+}
+
+// WithParseMode sets optional parameter parse_mode.
+func (r *CopyMessageRequest) WithParseMode(v ParseMode) *CopyMessageRequest {
+	r.ParseMode = v
+	return r
+}
+
+// WithCaptionEntities sets optional parameter caption_entities.
+func (r *CopyMessageRequest) WithCaptionEntities(v []MessageEntity) *CopyMessageRequest {
+	r.CaptionEntities = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *CopyMessageRequest) WithDisableNotification(v bool) *CopyMessageRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *CopyMessageRequest) WithReplyToMessageID(v int) *CopyMessageRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *CopyMessageRequest) WithAllowSendingWithoutReply(v bool) *CopyMessageRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *CopyMessageRequest) WithReplyMarkup(v AnyKeyboard) *CopyMessageRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// HTML sets parse_mode to ParseModeHTML.
+func (r *CopyMessageRequest) HTML() *CopyMessageRequest {
+	r.ParseMode = ParseModeHTML
+	return r
+}
+
+// MarkdownV2 sets parse_mode to ParseModeMarkdownV2.
+func (r *CopyMessageRequest) MarkdownV2() *CopyMessageRequest {
+	r.ParseMode = ParseModeMarkdownV2
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *CopyMessageRequest) Silent() *CopyMessageRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *CopyMessageRequest) ReplyTo(v int) *CopyMessageRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *CopyMessageRequest) Keyboard(v AnyKeyboard) *CopyMessageRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewPhoto creates SendPhotoRequest with the required parameters.
+func NewPhoto(chatID string, photo Fileable) *SendPhotoRequest {
+	return &SendPhotoRequest{
+		ChatID: chatID,
+		Photo:  photo,
+	}
+}
+
+// WithCaption sets optional parameter caption.
+func (r *SendPhotoRequest) WithCaption(v string) *SendPhotoRequest {
+	r.Caption = v
+	return r
+}
+
+// WithParseMode sets optional parameter parse_mode.
+func (r *SendPhotoRequest) WithParseMode(v ParseMode) *SendPhotoRequest {
+	r.ParseMode = v
+	return r
+}
+
+// WithCaptionEntities sets optional parameter caption_entities.
+func (r *SendPhotoRequest) WithCaptionEntities(v []MessageEntity) *SendPhotoRequest {
+	r.CaptionEntities = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendPhotoRequest) WithDisableNotification(v bool) *SendPhotoRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendPhotoRequest) WithReplyToMessageID(v int) *SendPhotoRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendPhotoRequest) WithAllowSendingWithoutReply(v bool) *SendPhotoRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendPhotoRequest) WithReplyMarkup(v AnyKeyboard) *SendPhotoRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// HTML sets parse_mode to ParseModeHTML.
+func (r *SendPhotoRequest) HTML() *SendPhotoRequest {
+	r.ParseMode = ParseModeHTML
+	return r
+}
+
+// MarkdownV2 sets parse_mode to ParseModeMarkdownV2.
+func (r *SendPhotoRequest) MarkdownV2() *SendPhotoRequest {
+	r.ParseMode = ParseModeMarkdownV2
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendPhotoRequest) Silent() *SendPhotoRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendPhotoRequest) ReplyTo(v int) *SendPhotoRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendPhotoRequest) Keyboard(v AnyKeyboard) *SendPhotoRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewAudio creates SendAudioRequest with the required parameters.
+func NewAudio(chatID string, audio Fileable) *SendAudioRequest {
+	return &SendAudioRequest{
+		Audio:  audio,
+		ChatID: chatID,
+	}
+}
+
+// WithCaption sets optional parameter caption.
+func (r *SendAudioRequest) WithCaption(v string) *SendAudioRequest {
+	r.Caption = v
+	return r
+}
+
+// WithParseMode sets optional parameter parse_mode.
+func (r *SendAudioRequest) WithParseMode(v ParseMode) *SendAudioRequest {
+	r.ParseMode = v
+	return r
+}
+
+// WithCaptionEntities sets optional parameter caption_entities.
+func (r *SendAudioRequest) WithCaptionEntities(v []MessageEntity) *SendAudioRequest {
+	r.CaptionEntities = v
+	return r
+}
+
+// WithDuration sets optional parameter duration.
+func (r *SendAudioRequest) WithDuration(v int) *SendAudioRequest {
+	r.Duration = v
+	return r
+}
+
+// WithPerformer sets optional parameter performer.
+func (r *SendAudioRequest) WithPerformer(v string) *SendAudioRequest {
+	r.Performer = v
+	return r
+}
+
+// WithTitle sets optional parameter title.
+func (r *SendAudioRequest) WithTitle(v string) *SendAudioRequest {
+	r.Title = v
+	return r
+}
+
+// WithThumb sets optional parameter thumb.
+func (r *SendAudioRequest) WithThumb(v Fileable) *SendAudioRequest {
+	r.Thumb = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendAudioRequest) WithDisableNotification(v bool) *SendAudioRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendAudioRequest) WithReplyToMessageID(v int) *SendAudioRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendAudioRequest) WithAllowSendingWithoutReply(v bool) *SendAudioRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendAudioRequest) WithReplyMarkup(v AnyKeyboard) *SendAudioRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// HTML sets parse_mode to ParseModeHTML.
+func (r *SendAudioRequest) HTML() *SendAudioRequest {
+	r.ParseMode = ParseModeHTML
+	return r
+}
+
+// MarkdownV2 sets parse_mode to ParseModeMarkdownV2.
+func (r *SendAudioRequest) MarkdownV2() *SendAudioRequest {
+	r.ParseMode = ParseModeMarkdownV2
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendAudioRequest) Silent() *SendAudioRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendAudioRequest) ReplyTo(v int) *SendAudioRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendAudioRequest) Keyboard(v AnyKeyboard) *SendAudioRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewDocument creates SendDocumentRequest with the required parameters.
+func NewDocument(chatID string, document Fileable) *SendDocumentRequest {
+	return &SendDocumentRequest{
+		ChatID:   chatID,
+		Document: document,
+	}
+}
+
+// WithThumb sets optional parameter thumb.
+func (r *SendDocumentRequest) WithThumb(v Fileable) *SendDocumentRequest {
+	r.Thumb = v
+	return r
+}
+
+// WithCaption sets optional parameter caption.
+func (r *SendDocumentRequest) WithCaption(v string) *SendDocumentRequest {
+	r.Caption = v
+	return r
+}
+
+// WithParseMode sets optional parameter parse_mode.
+func (r *SendDocumentRequest) WithParseMode(v ParseMode) *SendDocumentRequest {
+	r.ParseMode = v
+	return r
+}
+
+// WithCaptionEntities sets optional parameter caption_entities.
+func (r *SendDocumentRequest) WithCaptionEntities(v []MessageEntity) *SendDocumentRequest {
+	r.CaptionEntities = v
+	return r
+}
+
+// WithDisableContentTypeDetection sets optional parameter disable_content_type_detection.
+func (r *SendDocumentRequest) WithDisableContentTypeDetection(v bool) *SendDocumentRequest {
+	r.DisableContentTypeDetection = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendDocumentRequest) WithDisableNotification(v bool) *SendDocumentRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendDocumentRequest) WithReplyToMessageID(v int) *SendDocumentRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendDocumentRequest) WithAllowSendingWithoutReply(v bool) *SendDocumentRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendDocumentRequest) WithReplyMarkup(v AnyKeyboard) *SendDocumentRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// HTML sets parse_mode to ParseModeHTML.
+func (r *SendDocumentRequest) HTML() *SendDocumentRequest {
+	r.ParseMode = ParseModeHTML
+	return r
+}
+
+// MarkdownV2 sets parse_mode to ParseModeMarkdownV2.
+func (r *SendDocumentRequest) MarkdownV2() *SendDocumentRequest {
+	r.ParseMode = ParseModeMarkdownV2
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendDocumentRequest) Silent() *SendDocumentRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendDocumentRequest) ReplyTo(v int) *SendDocumentRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendDocumentRequest) Keyboard(v AnyKeyboard) *SendDocumentRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewVideo creates SendVideoRequest with the required parameters.
+func NewVideo(chatID string, video Fileable) *SendVideoRequest {
+	return &SendVideoRequest{
+		ChatID: chatID,
+		Video:  video,
+	}
+}
+
+// WithDuration sets optional parameter duration.
+func (r *SendVideoRequest) WithDuration(v int) *SendVideoRequest {
+	r.Duration = v
+	return r
+}
+
+// WithWidth sets optional parameter width.
+func (r *SendVideoRequest) WithWidth(v int) *SendVideoRequest {
+	r.Width = v
+	return r
+}
+
+// WithHeight sets optional parameter height.
+func (r *SendVideoRequest) WithHeight(v int) *SendVideoRequest {
+	r.Height = v
+	return r
+}
+
+// WithThumb sets optional parameter thumb.
+func (r *SendVideoRequest) WithThumb(v Fileable) *SendVideoRequest {
+	r.Thumb = v
+	return r
+}
+
+// WithCaption sets optional parameter caption.
+func (r *SendVideoRequest) WithCaption(v string) *SendVideoRequest {
+	r.Caption = v
+	return r
+}
+
+// WithParseMode sets optional parameter parse_mode.
+func (r *SendVideoRequest) WithParseMode(v ParseMode) *SendVideoRequest {
+	r.ParseMode = v
+	return r
+}
+
+// WithCaptionEntities sets optional parameter caption_entities.
+func (r *SendVideoRequest) WithCaptionEntities(v []MessageEntity) *SendVideoRequest {
+	r.CaptionEntities = v
+	return r
+}
+
+// WithSupportsStreaming sets optional parameter supports_streaming.
+func (r *SendVideoRequest) WithSupportsStreaming(v bool) *SendVideoRequest {
+	r.SupportsStreaming = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendVideoRequest) WithDisableNotification(v bool) *SendVideoRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendVideoRequest) WithReplyToMessageID(v int) *SendVideoRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendVideoRequest) WithAllowSendingWithoutReply(v bool) *SendVideoRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendVideoRequest) WithReplyMarkup(v AnyKeyboard) *SendVideoRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// HTML sets parse_mode to ParseModeHTML.
+func (r *SendVideoRequest) HTML() *SendVideoRequest {
+	r.ParseMode = ParseModeHTML
+	return r
+}
+
+// MarkdownV2 sets parse_mode to ParseModeMarkdownV2.
+func (r *SendVideoRequest) MarkdownV2() *SendVideoRequest {
+	r.ParseMode = ParseModeMarkdownV2
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendVideoRequest) Silent() *SendVideoRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendVideoRequest) ReplyTo(v int) *SendVideoRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendVideoRequest) Keyboard(v AnyKeyboard) *SendVideoRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewAnimation creates SendAnimationRequest with the required parameters.
+func NewAnimation(chatID string, animation Fileable) *SendAnimationRequest {
+	return &SendAnimationRequest{
+		Animation: animation,
+		ChatID:    chatID,
+	}
+}
+
+// WithDuration sets optional parameter duration.
+func (r *SendAnimationRequest) WithDuration(v int) *SendAnimationRequest {
+	r.Duration = v
+	return r
+}
+
+// WithWidth sets optional parameter width.
+func (r *SendAnimationRequest) WithWidth(v int) *SendAnimationRequest {
+	r.Width = v
+	return r
+}
+
+// WithHeight sets optional parameter height.
+func (r *SendAnimationRequest) WithHeight(v int) *SendAnimationRequest {
+	r.Height = v
+	return r
+}
+
+// WithThumb sets optional parameter thumb.
+func (r *SendAnimationRequest) WithThumb(v Fileable) *SendAnimationRequest {
+	r.Thumb = v
+	return r
+}
+
+// WithCaption sets optional parameter caption.
+func (r *SendAnimationRequest) WithCaption(v string) *SendAnimationRequest {
+	r.Caption = v
+	return r
+}
+
+// WithParseMode sets optional parameter parse_mode.
+func (r *SendAnimationRequest) WithParseMode(v ParseMode) *SendAnimationRequest {
+	r.ParseMode = v
+	return r
+}
+
+// WithCaptionEntities sets optional parameter caption_entities.
+func (r *SendAnimationRequest) WithCaptionEntities(v []MessageEntity) *SendAnimationRequest {
+	r.CaptionEntities = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendAnimationRequest) WithDisableNotification(v bool) *SendAnimationRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendAnimationRequest) WithReplyToMessageID(v int) *SendAnimationRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendAnimationRequest) WithAllowSendingWithoutReply(v bool) *SendAnimationRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendAnimationRequest) WithReplyMarkup(v AnyKeyboard) *SendAnimationRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// HTML sets parse_mode to ParseModeHTML.
+func (r *SendAnimationRequest) HTML() *SendAnimationRequest {
+	r.ParseMode = ParseModeHTML
+	return r
+}
+
+// MarkdownV2 sets parse_mode to ParseModeMarkdownV2.
+func (r *SendAnimationRequest) MarkdownV2() *SendAnimationRequest {
+	r.ParseMode = ParseModeMarkdownV2
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendAnimationRequest) Silent() *SendAnimationRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendAnimationRequest) ReplyTo(v int) *SendAnimationRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendAnimationRequest) Keyboard(v AnyKeyboard) *SendAnimationRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewVoice creates SendVoiceRequest with the required parameters.
+func NewVoice(chatID string, voice Fileable) *SendVoiceRequest {
+	return &SendVoiceRequest{
+		ChatID: chatID,
+		Voice:  voice,
+	}
+}
+
+// WithCaption sets optional parameter caption.
+func (r *SendVoiceRequest) WithCaption(v string) *SendVoiceRequest {
+	r.Caption = v
+	return r
+}
+
+// WithParseMode sets optional parameter parse_mode.
+func (r *SendVoiceRequest) WithParseMode(v ParseMode) *SendVoiceRequest {
+	r.ParseMode = v
+	return r
+}
+
+// WithCaptionEntities sets optional parameter caption_entities.
+func (r *SendVoiceRequest) WithCaptionEntities(v []MessageEntity) *SendVoiceRequest {
+	r.CaptionEntities = v
+	return r
+}
+
+// WithDuration sets optional parameter duration.
+func (r *SendVoiceRequest) WithDuration(v int) *SendVoiceRequest {
+	r.Duration = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendVoiceRequest) WithDisableNotification(v bool) *SendVoiceRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendVoiceRequest) WithReplyToMessageID(v int) *SendVoiceRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendVoiceRequest) WithAllowSendingWithoutReply(v bool) *SendVoiceRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendVoiceRequest) WithReplyMarkup(v AnyKeyboard) *SendVoiceRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// HTML sets parse_mode to ParseModeHTML.
+func (r *SendVoiceRequest) HTML() *SendVoiceRequest {
+	r.ParseMode = ParseModeHTML
+	return r
+}
+
+// MarkdownV2 sets parse_mode to ParseModeMarkdownV2.
+func (r *SendVoiceRequest) MarkdownV2() *SendVoiceRequest {
+	r.ParseMode = ParseModeMarkdownV2
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendVoiceRequest) Silent() *SendVoiceRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendVoiceRequest) ReplyTo(v int) *SendVoiceRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendVoiceRequest) Keyboard(v AnyKeyboard) *SendVoiceRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewVideoNote creates SendVideoNoteRequest with the required parameters.
+func NewVideoNote(chatID string, videoNote Fileable) *SendVideoNoteRequest {
+	return &SendVideoNoteRequest{
+		ChatID:    chatID,
+		VideoNote: videoNote,
+	}
+}
+
+// WithDuration sets optional parameter duration.
+func (r *SendVideoNoteRequest) WithDuration(v int) *SendVideoNoteRequest {
+	r.Duration = v
+	return r
+}
+
+// WithLength sets optional parameter length.
+func (r *SendVideoNoteRequest) WithLength(v int) *SendVideoNoteRequest {
+	r.Length = v
+	return r
+}
+
+// WithThumb sets optional parameter thumb.
+func (r *SendVideoNoteRequest) WithThumb(v Fileable) *SendVideoNoteRequest {
+	r.Thumb = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendVideoNoteRequest) WithDisableNotification(v bool) *SendVideoNoteRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendVideoNoteRequest) WithReplyToMessageID(v int) *SendVideoNoteRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendVideoNoteRequest) WithAllowSendingWithoutReply(v bool) *SendVideoNoteRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendVideoNoteRequest) WithReplyMarkup(v AnyKeyboard) *SendVideoNoteRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendVideoNoteRequest) Silent() *SendVideoNoteRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendVideoNoteRequest) ReplyTo(v int) *SendVideoNoteRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendVideoNoteRequest) Keyboard(v AnyKeyboard) *SendVideoNoteRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewLocation creates SendLocationRequest with the required parameters.
+func NewLocation(chatID string, latitude float64, longitude float64) *SendLocationRequest {
+	return &SendLocationRequest{
+		ChatID:    chatID,
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
+}
+
+// WithHorizontalAccuracy sets optional parameter horizontal_accuracy.
+func (r *SendLocationRequest) WithHorizontalAccuracy(v float64) *SendLocationRequest {
+	r.HorizontalAccuracy = v
+	return r
+}
+
+// WithLivePeriod sets optional parameter live_period.
+func (r *SendLocationRequest) WithLivePeriod(v int) *SendLocationRequest {
+	r.LivePeriod = v
+	return r
+}
+
+// WithHeading sets optional parameter heading.
+func (r *SendLocationRequest) WithHeading(v int) *SendLocationRequest {
+	r.Heading = v
+	return r
+}
+
+// WithProximityAlertRadius sets optional parameter proximity_alert_radius.
+func (r *SendLocationRequest) WithProximityAlertRadius(v int) *SendLocationRequest {
+	r.ProximityAlertRadius = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendLocationRequest) WithDisableNotification(v bool) *SendLocationRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendLocationRequest) WithReplyToMessageID(v int) *SendLocationRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendLocationRequest) WithAllowSendingWithoutReply(v bool) *SendLocationRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendLocationRequest) WithReplyMarkup(v AnyKeyboard) *SendLocationRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendLocationRequest) Silent() *SendLocationRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendLocationRequest) ReplyTo(v int) *SendLocationRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendLocationRequest) Keyboard(v AnyKeyboard) *SendLocationRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewEditMessageLiveLocation creates EditMessageLiveLocationRequest with the required parameters.
+func NewEditMessageLiveLocation(latitude float64, longitude float64) *EditMessageLiveLocationRequest {
+	return &EditMessageLiveLocationRequest{
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
+}
+
+// WithChatID sets optional parameter chat_id.
+func (r *EditMessageLiveLocationRequest) WithChatID(v string) *EditMessageLiveLocationRequest {
+	r.ChatID = v
+	return r
+}
+
+// WithMessageID sets optional parameter message_id.
+func (r *EditMessageLiveLocationRequest) WithMessageID(v int) *EditMessageLiveLocationRequest {
+	r.MessageID = v
+	return r
+}
+
+// WithInlineMessageID sets optional parameter inline_message_id.
+func (r *EditMessageLiveLocationRequest) WithInlineMessageID(v string) *EditMessageLiveLocationRequest {
+	r.InlineMessageID = v
+	return r
+}
+
+// WithHorizontalAccuracy sets optional parameter horizontal_accuracy.
+func (r *EditMessageLiveLocationRequest) WithHorizontalAccuracy(v float64) *EditMessageLiveLocationRequest {
+	r.HorizontalAccuracy = v
+	return r
+}
+
+// WithHeading sets optional parameter heading.
+func (r *EditMessageLiveLocationRequest) WithHeading(v int) *EditMessageLiveLocationRequest {
+	r.Heading = v
+	return r
+}
+
+// WithProximityAlertRadius sets optional parameter proximity_alert_radius.
+func (r *EditMessageLiveLocationRequest) WithProximityAlertRadius(v int) *EditMessageLiveLocationRequest {
+	r.ProximityAlertRadius = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *EditMessageLiveLocationRequest) WithReplyMarkup(v *InlineKeyboardMarkup) *EditMessageLiveLocationRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *EditMessageLiveLocationRequest) Keyboard(v *InlineKeyboardMarkup) *EditMessageLiveLocationRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewStopMessageLiveLocation creates StopMessageLiveLocationRequest with the required parameters.
+func NewStopMessageLiveLocation() *StopMessageLiveLocationRequest {
+	return &StopMessageLiveLocationRequest{}
+}
+
+// WithChatID sets optional parameter chat_id.
+func (r *StopMessageLiveLocationRequest) WithChatID(v string) *StopMessageLiveLocationRequest {
+	r.ChatID = v
+	return r
+}
+
+// WithMessageID sets optional parameter message_id.
+func (r *StopMessageLiveLocationRequest) WithMessageID(v int) *StopMessageLiveLocationRequest {
+	r.MessageID = v
+	return r
+}
+
+// WithInlineMessageID sets optional parameter inline_message_id.
+func (r *StopMessageLiveLocationRequest) WithInlineMessageID(v string) *StopMessageLiveLocationRequest {
+	r.InlineMessageID = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *StopMessageLiveLocationRequest) WithReplyMarkup(v *InlineKeyboardMarkup) *StopMessageLiveLocationRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *StopMessageLiveLocationRequest) Keyboard(v *InlineKeyboardMarkup) *StopMessageLiveLocationRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewVenue creates SendVenueRequest with the required parameters.
+func NewVenue(chatID string, latitude float64, longitude float64, title string, address string) *SendVenueRequest {
+	return &SendVenueRequest{
+		Address:   address,
+		ChatID:    chatID,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Title:     title,
+	}
+}
+
+// WithFoursquareID sets optional parameter foursquare_id.
+func (r *SendVenueRequest) WithFoursquareID(v string) *SendVenueRequest {
+	r.FoursquareID = v
+	return r
+}
+
+// WithFoursquareType sets optional parameter foursquare_type.
+func (r *SendVenueRequest) WithFoursquareType(v string) *SendVenueRequest {
+	r.FoursquareType = v
+	return r
+}
+
+// WithGooglePlaceID sets optional parameter google_place_id.
+func (r *SendVenueRequest) WithGooglePlaceID(v string) *SendVenueRequest {
+	r.GooglePlaceID = v
+	return r
+}
+
+// WithGooglePlaceType sets optional parameter google_place_type.
+func (r *SendVenueRequest) WithGooglePlaceType(v string) *SendVenueRequest {
+	r.GooglePlaceType = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendVenueRequest) WithDisableNotification(v bool) *SendVenueRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendVenueRequest) WithReplyToMessageID(v int) *SendVenueRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendVenueRequest) WithAllowSendingWithoutReply(v bool) *SendVenueRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendVenueRequest) WithReplyMarkup(v AnyKeyboard) *SendVenueRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendVenueRequest) Silent() *SendVenueRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendVenueRequest) ReplyTo(v int) *SendVenueRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendVenueRequest) Keyboard(v AnyKeyboard) *SendVenueRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewContact creates SendContactRequest with the required parameters.
+func NewContact(chatID string, phoneNumber string, firstName string) *SendContactRequest {
+	return &SendContactRequest{
+		ChatID:      chatID,
+		FirstName:   firstName,
+		PhoneNumber: phoneNumber,
+	}
+}
+
+// WithLastName sets optional parameter last_name.
+func (r *SendContactRequest) WithLastName(v string) *SendContactRequest {
+	r.LastName = v
+	return r
+}
+
+// WithVcard sets optional parameter vcard.
+func (r *SendContactRequest) WithVcard(v string) *SendContactRequest {
+	r.Vcard = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendContactRequest) WithDisableNotification(v bool) *SendContactRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendContactRequest) WithReplyToMessageID(v int) *SendContactRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendContactRequest) WithAllowSendingWithoutReply(v bool) *SendContactRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendContactRequest) WithReplyMarkup(v AnyKeyboard) *SendContactRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendContactRequest) Silent() *SendContactRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendContactRequest) ReplyTo(v int) *SendContactRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendContactRequest) Keyboard(v AnyKeyboard) *SendContactRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewPoll creates SendPollRequest with the required parameters.
+func NewPoll(chatID string, question string, options []string) *SendPollRequest {
+	return &SendPollRequest{
+		ChatID:   chatID,
+		Options:  options,
+		Question: question,
+	}
+}
+
+// WithIsAnonymous sets optional parameter is_anonymous.
+func (r *SendPollRequest) WithIsAnonymous(v bool) *SendPollRequest {
+	r.IsAnonymous = &v
+	return r
+}
+
+// WithType sets optional parameter type.
+func (r *SendPollRequest) WithType(v PollType) *SendPollRequest {
+	r.Type = v
+	return r
+}
+
+// WithAllowsMultipleAnswers sets optional parameter allows_multiple_answers.
+func (r *SendPollRequest) WithAllowsMultipleAnswers(v bool) *SendPollRequest {
+	r.AllowsMultipleAnswers = v
+	return r
+}
+
+// WithCorrectOptionID sets optional parameter correct_option_id.
+func (r *SendPollRequest) WithCorrectOptionID(v int) *SendPollRequest {
+	r.CorrectOptionID = &v
+	return r
+}
+
+// WithExplanation sets optional parameter explanation.
+func (r *SendPollRequest) WithExplanation(v string) *SendPollRequest {
+	r.Explanation = v
+	return r
+}
+
+// WithExplanationParseMode sets optional parameter explanation_parse_mode.
+func (r *SendPollRequest) WithExplanationParseMode(v ParseMode) *SendPollRequest {
+	r.ExplanationParseMode = v
+	return r
+}
+
+// WithExplanationEntities sets optional parameter explanation_entities.
+func (r *SendPollRequest) WithExplanationEntities(v []MessageEntity) *SendPollRequest {
+	r.ExplanationEntities = v
+	return r
+}
+
+// WithOpenPeriod sets optional parameter open_period.
+func (r *SendPollRequest) WithOpenPeriod(v int) *SendPollRequest {
+	r.OpenPeriod = v
+	return r
+}
+
+// WithCloseDate sets optional parameter close_date.
+func (r *SendPollRequest) WithCloseDate(v int) *SendPollRequest {
+	r.CloseDate = v
+	return r
+}
+
+// WithIsClosed sets optional parameter is_closed.
+func (r *SendPollRequest) WithIsClosed(v bool) *SendPollRequest {
+	r.IsClosed = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendPollRequest) WithDisableNotification(v bool) *SendPollRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendPollRequest) WithReplyToMessageID(v int) *SendPollRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendPollRequest) WithAllowSendingWithoutReply(v bool) *SendPollRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendPollRequest) WithReplyMarkup(v AnyKeyboard) *SendPollRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendPollRequest) Silent() *SendPollRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendPollRequest) ReplyTo(v int) *SendPollRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendPollRequest) Keyboard(v AnyKeyboard) *SendPollRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewDice creates SendDiceRequest with the required parameters.
+func NewDice(chatID string) *SendDiceRequest {
+	return &SendDiceRequest{ChatID: chatID}
+}
+
+// WithEmoji sets optional parameter emoji.
+func (r *SendDiceRequest) WithEmoji(v DiceEmoji) *SendDiceRequest {
+	r.Emoji = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendDiceRequest) WithDisableNotification(v bool) *SendDiceRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendDiceRequest) WithReplyToMessageID(v int) *SendDiceRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendDiceRequest) WithAllowSendingWithoutReply(v bool) *SendDiceRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendDiceRequest) WithReplyMarkup(v AnyKeyboard) *SendDiceRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendDiceRequest) Silent() *SendDiceRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendDiceRequest) ReplyTo(v int) *SendDiceRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendDiceRequest) Keyboard(v AnyKeyboard) *SendDiceRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewChatAction creates SendChatActionRequest with the required parameters.
+func NewChatAction(chatID string, action ChatAction) *SendChatActionRequest {
+	return &SendChatActionRequest{
+		Action: action,
+		ChatID: chatID,
+	}
+}
+
+// NewGetUserProfilePhotos creates GetUserProfilePhotosRequest with the required parameters.
+func NewGetUserProfilePhotos(userID int) *GetUserProfilePhotosRequest {
+	return &GetUserProfilePhotosRequest{UserID: userID}
+}
+
+// WithOffset sets optional parameter offset.
+func (r *GetUserProfilePhotosRequest) WithOffset(v int) *GetUserProfilePhotosRequest {
+	r.Offset = v
+	return r
+}
+
+// WithLimit sets optional parameter limit.
+func (r *GetUserProfilePhotosRequest) WithLimit(v int) *GetUserProfilePhotosRequest {
+	r.Limit = v
+	return r
+}
+
+// NewGetFile creates GetFileRequest with the required parameters.
+func NewGetFile(fileID string) *GetFileRequest {
+	return &GetFileRequest{FileID: fileID}
+}
+
+// NewKickChatMember creates KickChatMemberRequest with the required parameters.
+func NewKickChatMember(chatID string, userID int) *KickChatMemberRequest {
+	return &KickChatMemberRequest{
+		ChatID: chatID,
+		UserID: userID,
+	}
+}
+
+// WithUntilDate sets optional parameter until_date.
+func (r *KickChatMemberRequest) WithUntilDate(v int) *KickChatMemberRequest {
+	r.UntilDate = v
+	return r
+}
+
+// WithRevokeMessages sets optional parameter revoke_messages.
+func (r *KickChatMemberRequest) WithRevokeMessages(v bool) *KickChatMemberRequest {
+	r.RevokeMessages = v
+	return r
+}
+
+// NewUnbanChatMember creates UnbanChatMemberRequest with the required parameters.
+func NewUnbanChatMember(chatID string, userID int) *UnbanChatMemberRequest {
+	return &UnbanChatMemberRequest{
+		ChatID: chatID,
+		UserID: userID,
+	}
+}
+
+// WithOnlyIfBanned sets optional parameter only_if_banned.
+func (r *UnbanChatMemberRequest) WithOnlyIfBanned(v bool) *UnbanChatMemberRequest {
+	r.OnlyIfBanned = v
+	return r
+}
+
+// NewRestrictChatMember creates RestrictChatMemberRequest with the required parameters.
+func NewRestrictChatMember(chatID string, userID int, permissions *ChatPermissions) *RestrictChatMemberRequest {
+	return &RestrictChatMemberRequest{
+		ChatID:      chatID,
+		Permissions: permissions,
+		UserID:      userID,
+	}
+}
+
+// WithUntilDate sets optional parameter until_date.
+func (r *RestrictChatMemberRequest) WithUntilDate(v int) *RestrictChatMemberRequest {
+	r.UntilDate = v
+	return r
+}
+
+// NewPromoteChatMember creates PromoteChatMemberRequest with the required parameters.
+func NewPromoteChatMember(chatID string, userID int) *PromoteChatMemberRequest {
+	return &PromoteChatMemberRequest{
+		ChatID: chatID,
+		UserID: userID,
+	}
+}
+
+// WithIsAnonymous sets optional parameter is_anonymous.
+func (r *PromoteChatMemberRequest) WithIsAnonymous(v bool) *PromoteChatMemberRequest {
+	r.IsAnonymous = v
+	return r
+}
+
+// WithCanManageChat sets optional parameter can_manage_chat.
+func (r *PromoteChatMemberRequest) WithCanManageChat(v bool) *PromoteChatMemberRequest {
+	r.CanManageChat = v
+	return r
+}
+
+// WithCanPostMessages sets optional parameter can_post_messages.
+func (r *PromoteChatMemberRequest) WithCanPostMessages(v bool) *PromoteChatMemberRequest {
+	r.CanPostMessages = v
+	return r
+}
+
+// WithCanEditMessages sets optional parameter can_edit_messages.
+func (r *PromoteChatMemberRequest) WithCanEditMessages(v bool) *PromoteChatMemberRequest {
+	r.CanEditMessages = v
+	return r
+}
+
+// WithCanDeleteMessages sets optional parameter can_delete_messages.
+func (r *PromoteChatMemberRequest) WithCanDeleteMessages(v bool) *PromoteChatMemberRequest {
+	r.CanDeleteMessages = v
+	return r
+}
+
+// WithCanManageVoiceChats sets optional parameter can_manage_voice_chats.
+func (r *PromoteChatMemberRequest) WithCanManageVoiceChats(v bool) *PromoteChatMemberRequest {
+	r.CanManageVoiceChats = v
+	return r
+}
+
+// WithCanRestrictMembers sets optional parameter can_restrict_members.
+func (r *PromoteChatMemberRequest) WithCanRestrictMembers(v bool) *PromoteChatMemberRequest {
+	r.CanRestrictMembers = v
+	return r
+}
+
+// WithCanPromoteMembers sets optional parameter can_promote_members.
+func (r *PromoteChatMemberRequest) WithCanPromoteMembers(v bool) *PromoteChatMemberRequest {
+	r.CanPromoteMembers = v
+	return r
+}
+
+// WithCanChangeInfo sets optional parameter can_change_info.
+func (r *PromoteChatMemberRequest) WithCanChangeInfo(v bool) *PromoteChatMemberRequest {
+	r.CanChangeInfo = v
+	return r
+}
+
+// WithCanInviteUsers sets optional parameter can_invite_users.
+func (r *PromoteChatMemberRequest) WithCanInviteUsers(v bool) *PromoteChatMemberRequest {
+	r.CanInviteUsers = v
+	return r
+}
+
+// WithCanPinMessages sets optional parameter can_pin_messages.
+func (r *PromoteChatMemberRequest) WithCanPinMessages(v bool) *PromoteChatMemberRequest {
+	r.CanPinMessages = v
+	return r
+}
+
+// NewSetChatAdministratorCustomTitle creates SetChatAdministratorCustomTitleRequest with the required parameters.
+func NewSetChatAdministratorCustomTitle(chatID string, userID int, customTitle string) *SetChatAdministratorCustomTitleRequest {
+	return &SetChatAdministratorCustomTitleRequest{
+		ChatID:      chatID,
+		CustomTitle: customTitle,
+		UserID:      userID,
+	}
+}
+
+// NewSetChatPermissions creates SetChatPermissionsRequest with the required parameters.
+func NewSetChatPermissions(chatID string, permissions *ChatPermissions) *SetChatPermissionsRequest {
+	return &SetChatPermissionsRequest{
+		ChatID:      chatID,
+		Permissions: permissions,
+	}
+}
+
+// NewExportChatInviteLink creates ExportChatInviteLinkRequest with the required parameters.
+func NewExportChatInviteLink(chatID string) *ExportChatInviteLinkRequest {
+	return &ExportChatInviteLinkRequest{ChatID: chatID}
+}
+
+// NewCreateChatInviteLink creates CreateChatInviteLinkRequest with the required parameters.
+func NewCreateChatInviteLink(chatID string) *CreateChatInviteLinkRequest {
+	return &CreateChatInviteLinkRequest{ChatID: chatID}
+}
+
+// WithExpireDate sets optional parameter expire_date.
+func (r *CreateChatInviteLinkRequest) WithExpireDate(v int) *CreateChatInviteLinkRequest {
+	r.ExpireDate = v
+	return r
+}
+
+// WithMemberLimit sets optional parameter member_limit.
+func (r *CreateChatInviteLinkRequest) WithMemberLimit(v int) *CreateChatInviteLinkRequest {
+	r.MemberLimit = v
+	return r
+}
+
+// NewEditChatInviteLink creates EditChatInviteLinkRequest with the required parameters.
+func NewEditChatInviteLink(chatID string, inviteLink string) *EditChatInviteLinkRequest {
+	return &EditChatInviteLinkRequest{
+		ChatID:     chatID,
+		InviteLink: inviteLink,
+	}
+}
+
+// WithExpireDate sets optional parameter expire_date.
+func (r *EditChatInviteLinkRequest) WithExpireDate(v int) *EditChatInviteLinkRequest {
+	r.ExpireDate = v
+	return r
+}
+
+// WithMemberLimit sets optional parameter member_limit.
+func (r *EditChatInviteLinkRequest) WithMemberLimit(v int) *EditChatInviteLinkRequest {
+	r.MemberLimit = v
+	return r
+}
+
+// NewRevokeChatInviteLink creates RevokeChatInviteLinkRequest with the required parameters.
+func NewRevokeChatInviteLink(chatID string, inviteLink string) *RevokeChatInviteLinkRequest {
+	return &RevokeChatInviteLinkRequest{
+		ChatID:     chatID,
+		InviteLink: inviteLink,
+	}
+}
+
+// NewSetChatPhoto creates SetChatPhotoRequest with the required parameters.
+func NewSetChatPhoto(chatID string, photo *InputFile) *SetChatPhotoRequest {
+	return &SetChatPhotoRequest{
+		ChatID: chatID,
+		Photo:  photo,
+	}
+}
+
+// NewDeleteChatPhoto creates DeleteChatPhotoRequest with the required parameters.
+func NewDeleteChatPhoto(chatID string) *DeleteChatPhotoRequest {
+	return &DeleteChatPhotoRequest{ChatID: chatID}
+}
+
+// NewSetChatTitle creates SetChatTitleRequest with the required parameters.
+func NewSetChatTitle(chatID string, title string) *SetChatTitleRequest {
+	return &SetChatTitleRequest{
+		ChatID: chatID,
+		Title:  title,
+	}
+}
+
+// NewSetChatDescription creates SetChatDescriptionRequest with the required parameters.
+func NewSetChatDescription(chatID string) *SetChatDescriptionRequest {
+	return &SetChatDescriptionRequest{ChatID: chatID}
+}
+
+// WithDescription sets optional parameter description.
+func (r *SetChatDescriptionRequest) WithDescription(v string) *SetChatDescriptionRequest {
+	r.Description = v
+	return r
+}
+
+// NewPinChatMessage creates PinChatMessageRequest with the required parameters.
+func NewPinChatMessage(chatID string, messageID int) *PinChatMessageRequest {
+	return &PinChatMessageRequest{
+		ChatID:    chatID,
+		MessageID: messageID,
+	}
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *PinChatMessageRequest) WithDisableNotification(v bool) *PinChatMessageRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *PinChatMessageRequest) Silent() *PinChatMessageRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// NewUnpinChatMessage creates UnpinChatMessageRequest with the required parameters.
+func NewUnpinChatMessage(chatID string) *UnpinChatMessageRequest {
+	return &UnpinChatMessageRequest{ChatID: chatID}
+}
+
+// WithMessageID sets optional parameter message_id.
+func (r *UnpinChatMessageRequest) WithMessageID(v int) *UnpinChatMessageRequest {
+	r.MessageID = v
+	return r
+}
+
+// NewUnpinAllChatMessages creates UnpinAllChatMessagesRequest with the required parameters.
+func NewUnpinAllChatMessages(chatID string) *UnpinAllChatMessagesRequest {
+	return &UnpinAllChatMessagesRequest{ChatID: chatID}
+}
+
+// NewLeaveChat creates LeaveChatRequest with the required parameters.
+func NewLeaveChat(chatID string) *LeaveChatRequest {
+	return &LeaveChatRequest{ChatID: chatID}
+}
+
+// NewGetChat creates GetChatRequest with the required parameters.
+func NewGetChat(chatID string) *GetChatRequest {
+	return &GetChatRequest{ChatID: chatID}
+}
+
+// NewGetChatAdministrators creates GetChatAdministratorsRequest with the required parameters.
+func NewGetChatAdministrators(chatID string) *GetChatAdministratorsRequest {
+	return &GetChatAdministratorsRequest{ChatID: chatID}
+}
+
+// NewGetChatMembersCount creates GetChatMembersCountRequest with the required parameters.
+func NewGetChatMembersCount(chatID string) *GetChatMembersCountRequest {
+	return &GetChatMembersCountRequest{ChatID: chatID}
+}
+
+// NewGetChatMember creates GetChatMemberRequest with the required parameters.
+func NewGetChatMember(chatID string, userID int) *GetChatMemberRequest {
+	return &GetChatMemberRequest{
+		ChatID: chatID,
+		UserID: userID,
+	}
+}
+
+// NewSetChatStickerSet creates SetChatStickerSetRequest with the required parameters.
+func NewSetChatStickerSet(chatID string, stickerSetName string) *SetChatStickerSetRequest {
+	return &SetChatStickerSetRequest{
+		ChatID:         chatID,
+		StickerSetName: stickerSetName,
+	}
+}
+
+// NewDeleteChatStickerSet creates DeleteChatStickerSetRequest with the required parameters.
+func NewDeleteChatStickerSet(chatID string) *DeleteChatStickerSetRequest {
+	return &DeleteChatStickerSetRequest{ChatID: chatID}
+}
+
+// NewAnswerCallbackQuery creates AnswerCallbackQueryRequest with the required parameters.
+func NewAnswerCallbackQuery(callbackQueryID string) *AnswerCallbackQueryRequest {
+	return &AnswerCallbackQueryRequest{CallbackQueryID: callbackQueryID}
+}
+
+// WithText sets optional parameter text.
+func (r *AnswerCallbackQueryRequest) WithText(v string) *AnswerCallbackQueryRequest {
+	r.Text = v
+	return r
+}
+
+// WithShowAlert sets optional parameter show_alert.
+func (r *AnswerCallbackQueryRequest) WithShowAlert(v bool) *AnswerCallbackQueryRequest {
+	r.ShowAlert = v
+	return r
+}
+
+// WithURL sets optional parameter url.
+func (r *AnswerCallbackQueryRequest) WithURL(v string) *AnswerCallbackQueryRequest {
+	r.URL = v
+	return r
+}
+
+// WithCacheTime sets optional parameter cache_time.
+func (r *AnswerCallbackQueryRequest) WithCacheTime(v int) *AnswerCallbackQueryRequest {
+	r.CacheTime = v
+	return r
+}
+
+// NewSetMyCommands creates SetMyCommandsRequest with the required parameters.
+func NewSetMyCommands(commands []BotCommand) *SetMyCommandsRequest {
+	return &SetMyCommandsRequest{Commands: commands}
+}
+
+// NewGetMyCommands creates GetMyCommandsRequest with the required parameters.
+func NewGetMyCommands() *GetMyCommandsRequest {
+	return &GetMyCommandsRequest{}
+}
+
+// NewGame creates SendGameRequest with the required parameters.
+func NewGame(chatID int, gameShortName string) *SendGameRequest {
+	return &SendGameRequest{
+		ChatID:        chatID,
+		GameShortName: gameShortName,
+	}
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendGameRequest) WithDisableNotification(v bool) *SendGameRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendGameRequest) WithReplyToMessageID(v int) *SendGameRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendGameRequest) WithAllowSendingWithoutReply(v bool) *SendGameRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendGameRequest) WithReplyMarkup(v *InlineKeyboardMarkup) *SendGameRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendGameRequest) Silent() *SendGameRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendGameRequest) ReplyTo(v int) *SendGameRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendGameRequest) Keyboard(v *InlineKeyboardMarkup) *SendGameRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewSetGameScore creates SetGameScoreRequest with the required parameters.
+func NewSetGameScore(userID int, score int) *SetGameScoreRequest {
+	return &SetGameScoreRequest{
+		Score:  score,
+		UserID: userID,
+	}
+}
+
+// WithForce sets optional parameter force.
+func (r *SetGameScoreRequest) WithForce(v bool) *SetGameScoreRequest {
+	r.Force = v
+	return r
+}
+
+// WithDisableEditMessage sets optional parameter disable_edit_message.
+func (r *SetGameScoreRequest) WithDisableEditMessage(v bool) *SetGameScoreRequest {
+	r.DisableEditMessage = v
+	return r
+}
+
+// WithChatID sets optional parameter chat_id.
+func (r *SetGameScoreRequest) WithChatID(v int) *SetGameScoreRequest {
+	r.ChatID = v
+	return r
+}
+
+// WithMessageID sets optional parameter message_id.
+func (r *SetGameScoreRequest) WithMessageID(v int) *SetGameScoreRequest {
+	r.MessageID = v
+	return r
+}
+
+// WithInlineMessageID sets optional parameter inline_message_id.
+func (r *SetGameScoreRequest) WithInlineMessageID(v string) *SetGameScoreRequest {
+	r.InlineMessageID = v
+	return r
+}
+
+// NewGetGameHighScores creates GetGameHighScoresRequest with the required parameters.
+func NewGetGameHighScores(userID int) *GetGameHighScoresRequest {
+	return &GetGameHighScoresRequest{UserID: userID}
+}
+
+// WithChatID sets optional parameter chat_id.
+func (r *GetGameHighScoresRequest) WithChatID(v int) *GetGameHighScoresRequest {
+	r.ChatID = v
+	return r
+}
+
+// WithMessageID sets optional parameter message_id.
+func (r *GetGameHighScoresRequest) WithMessageID(v int) *GetGameHighScoresRequest {
+	r.MessageID = v
+	return r
+}
+
+// WithInlineMessageID sets optional parameter inline_message_id.
+func (r *GetGameHighScoresRequest) WithInlineMessageID(v string) *GetGameHighScoresRequest {
+	r.InlineMessageID = v
+	return r
+}
+
+// NewGetUpdates creates GetUpdatesRequest with the required parameters.
+func NewGetUpdates() *GetUpdatesRequest {
+	return &GetUpdatesRequest{}
+}
+
+// WithOffset sets optional parameter offset.
+func (r *GetUpdatesRequest) WithOffset(v int) *GetUpdatesRequest {
+	r.Offset = v
+	return r
+}
+
+// WithLimit sets optional parameter limit.
+func (r *GetUpdatesRequest) WithLimit(v int) *GetUpdatesRequest {
+	r.Limit = v
+	return r
+}
+
+// WithTimeout sets optional parameter timeout.
+func (r *GetUpdatesRequest) WithTimeout(v int) *GetUpdatesRequest {
+	r.Timeout = v
+	return r
+}
+
+// WithAllowedUpdates sets optional parameter allowed_updates.
+func (r *GetUpdatesRequest) WithAllowedUpdates(v []string) *GetUpdatesRequest {
+	r.AllowedUpdates = v
+	return r
+}
+
+// NewSetWebhook creates SetWebhookRequest with the required parameters.
+func NewSetWebhook(url string) *SetWebhookRequest {
+	return &SetWebhookRequest{URL: url}
+}
+
+// WithCertificate sets optional parameter certificate.
+func (r *SetWebhookRequest) WithCertificate(v *InputFile) *SetWebhookRequest {
+	r.Certificate = v
+	return r
+}
+
+// WithIpAddress sets optional parameter ip_address.
+func (r *SetWebhookRequest) WithIpAddress(v string) *SetWebhookRequest {
+	r.IpAddress = v
+	return r
+}
+
+// WithMaxConnections sets optional parameter max_connections.
+func (r *SetWebhookRequest) WithMaxConnections(v int) *SetWebhookRequest {
+	r.MaxConnections = v
+	return r
+}
+
+// WithAllowedUpdates sets optional parameter allowed_updates.
+func (r *SetWebhookRequest) WithAllowedUpdates(v []string) *SetWebhookRequest {
+	r.AllowedUpdates = v
+	return r
+}
+
+// WithDropPendingUpdates sets optional parameter drop_pending_updates.
+func (r *SetWebhookRequest) WithDropPendingUpdates(v bool) *SetWebhookRequest {
+	r.DropPendingUpdates = v
+	return r
+}
+
+// NewDeleteWebhook creates DeleteWebhookRequest with the required parameters.
+func NewDeleteWebhook() *DeleteWebhookRequest {
+	return &DeleteWebhookRequest{}
+}
+
+// WithDropPendingUpdates sets optional parameter drop_pending_updates.
+func (r *DeleteWebhookRequest) WithDropPendingUpdates(v bool) *DeleteWebhookRequest {
+	r.DropPendingUpdates = v
+	return r
+}
+
+// NewGetWebhookInfo creates GetWebhookInfoRequest with the required parameters.
+func NewGetWebhookInfo() *GetWebhookInfoRequest {
+	return &GetWebhookInfoRequest{}
+}
+
+// NewAnswerInlineQuery creates AnswerInlineQueryRequest with the required parameters.
+func NewAnswerInlineQuery(inlineQueryID string, results []InlineQueryResult) *AnswerInlineQueryRequest {
+	return &AnswerInlineQueryRequest{
+		InlineQueryID: inlineQueryID,
+		Results:       results,
+	}
+}
+
+// WithCacheTime sets optional parameter cache_time.
+func (r *AnswerInlineQueryRequest) WithCacheTime(v int) *AnswerInlineQueryRequest {
+	r.CacheTime = &v
+	return r
+}
+
+// WithIsPersonal sets optional parameter is_personal.
+func (r *AnswerInlineQueryRequest) WithIsPersonal(v bool) *AnswerInlineQueryRequest {
+	r.IsPersonal = v
+	return r
+}
+
+// WithNextOffset sets optional parameter next_offset.
+func (r *AnswerInlineQueryRequest) WithNextOffset(v string) *AnswerInlineQueryRequest {
+	r.NextOffset = v
+	return r
+}
+
+// WithSwitchPmText sets optional parameter switch_pm_text.
+func (r *AnswerInlineQueryRequest) WithSwitchPmText(v string) *AnswerInlineQueryRequest {
+	r.SwitchPmText = v
+	return r
+}
+
+// WithSwitchPmParameter sets optional parameter switch_pm_parameter.
+func (r *AnswerInlineQueryRequest) WithSwitchPmParameter(v string) *AnswerInlineQueryRequest {
+	r.SwitchPmParameter = v
+	return r
+}
+
+// NewInvoice creates SendInvoiceRequest with the required parameters.
+func NewInvoice(chatID string, title string, description string, payload string, providerToken string, currency string, prices []LabeledPrice) *SendInvoiceRequest {
+	return &SendInvoiceRequest{
+		ChatID:        chatID,
+		Currency:      currency,
+		Description:   description,
+		Payload:       payload,
+		Prices:        prices,
+		ProviderToken: providerToken,
+		Title:         title,
+	}
+}
+
+// WithMaxTipAmount sets optional parameter max_tip_amount.
+func (r *SendInvoiceRequest) WithMaxTipAmount(v int) *SendInvoiceRequest {
+	r.MaxTipAmount = v
+	return r
+}
+
+// WithSuggestedTipAmounts sets optional parameter suggested_tip_amounts.
+func (r *SendInvoiceRequest) WithSuggestedTipAmounts(v []int) *SendInvoiceRequest {
+	r.SuggestedTipAmounts = v
+	return r
+}
+
+// WithStartParameter sets optional parameter start_parameter.
+func (r *SendInvoiceRequest) WithStartParameter(v string) *SendInvoiceRequest {
+	r.StartParameter = v
+	return r
+}
+
+// WithProviderData sets optional parameter provider_data.
+func (r *SendInvoiceRequest) WithProviderData(v string) *SendInvoiceRequest {
+	r.ProviderData = v
+	return r
+}
+
+// WithPhotoURL sets optional parameter photo_url.
+func (r *SendInvoiceRequest) WithPhotoURL(v string) *SendInvoiceRequest {
+	r.PhotoURL = v
+	return r
+}
+
+// WithPhotoSize sets optional parameter photo_size.
+func (r *SendInvoiceRequest) WithPhotoSize(v int) *SendInvoiceRequest {
+	r.PhotoSize = v
+	return r
+}
+
+// WithPhotoWidth sets optional parameter photo_width.
+func (r *SendInvoiceRequest) WithPhotoWidth(v int) *SendInvoiceRequest {
+	r.PhotoWidth = v
+	return r
+}
+
+// WithPhotoHeight sets optional parameter photo_height.
+func (r *SendInvoiceRequest) WithPhotoHeight(v int) *SendInvoiceRequest {
+	r.PhotoHeight = v
+	return r
+}
+
+// WithNeedName sets optional parameter need_name.
+func (r *SendInvoiceRequest) WithNeedName(v bool) *SendInvoiceRequest {
+	r.NeedName = v
+	return r
+}
+
+// WithNeedPhoneNumber sets optional parameter need_phone_number.
+func (r *SendInvoiceRequest) WithNeedPhoneNumber(v bool) *SendInvoiceRequest {
+	r.NeedPhoneNumber = v
+	return r
+}
+
+// WithNeedEmail sets optional parameter need_email.
+func (r *SendInvoiceRequest) WithNeedEmail(v bool) *SendInvoiceRequest {
+	r.NeedEmail = v
+	return r
+}
+
+// WithNeedShippingAddress sets optional parameter need_shipping_address.
+func (r *SendInvoiceRequest) WithNeedShippingAddress(v bool) *SendInvoiceRequest {
+	r.NeedShippingAddress = v
+	return r
+}
+
+// WithSendPhoneNumberToProvider sets optional parameter send_phone_number_to_provider.
+func (r *SendInvoiceRequest) WithSendPhoneNumberToProvider(v bool) *SendInvoiceRequest {
+	r.SendPhoneNumberToProvider = v
+	return r
+}
+
+// WithSendEmailToProvider sets optional parameter send_email_to_provider.
+func (r *SendInvoiceRequest) WithSendEmailToProvider(v bool) *SendInvoiceRequest {
+	r.SendEmailToProvider = v
+	return r
+}
+
+// WithIsFlexible sets optional parameter is_flexible.
+func (r *SendInvoiceRequest) WithIsFlexible(v bool) *SendInvoiceRequest {
+	r.IsFlexible = v
+	return r
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendInvoiceRequest) WithDisableNotification(v bool) *SendInvoiceRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendInvoiceRequest) WithReplyToMessageID(v int) *SendInvoiceRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendInvoiceRequest) WithAllowSendingWithoutReply(v bool) *SendInvoiceRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendInvoiceRequest) WithReplyMarkup(v *InlineKeyboardMarkup) *SendInvoiceRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendInvoiceRequest) Silent() *SendInvoiceRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendInvoiceRequest) ReplyTo(v int) *SendInvoiceRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendInvoiceRequest) Keyboard(v *InlineKeyboardMarkup) *SendInvoiceRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewAnswerShippingQuery creates AnswerShippingQueryRequest with the required parameters.
+func NewAnswerShippingQuery(shippingQueryID string, ok bool) *AnswerShippingQueryRequest {
+	return &AnswerShippingQueryRequest{
+		Ok:              ok,
+		ShippingQueryID: shippingQueryID,
+	}
+}
+
+// WithShippingOptions sets optional parameter shipping_options.
+func (r *AnswerShippingQueryRequest) WithShippingOptions(v []ShippingOption) *AnswerShippingQueryRequest {
+	r.ShippingOptions = v
+	return r
+}
+
+// WithErrorMessage sets optional parameter error_message.
+func (r *AnswerShippingQueryRequest) WithErrorMessage(v string) *AnswerShippingQueryRequest {
+	r.ErrorMessage = v
+	return r
+}
+
+// NewAnswerPreCheckoutQuery creates AnswerPreCheckoutQueryRequest with the required parameters.
+func NewAnswerPreCheckoutQuery(preCheckoutQueryID string, ok bool) *AnswerPreCheckoutQueryRequest {
+	return &AnswerPreCheckoutQueryRequest{
+		Ok:                 ok,
+		PreCheckoutQueryID: preCheckoutQueryID,
+	}
+}
+
+// WithErrorMessage sets optional parameter error_message.
+func (r *AnswerPreCheckoutQueryRequest) WithErrorMessage(v string) *AnswerPreCheckoutQueryRequest {
+	r.ErrorMessage = v
+	return r
+}
+
+// NewSticker creates SendStickerRequest with the required parameters.
+func NewSticker(chatID string, sticker Fileable) *SendStickerRequest {
+	return &SendStickerRequest{
+		ChatID:  chatID,
+		Sticker: sticker,
+	}
+}
+
+// WithDisableNotification sets optional parameter disable_notification.
+func (r *SendStickerRequest) WithDisableNotification(v bool) *SendStickerRequest {
+	r.DisableNotification = v
+	return r
+}
+
+// WithReplyToMessageID sets optional parameter reply_to_message_id.
+func (r *SendStickerRequest) WithReplyToMessageID(v int) *SendStickerRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// WithAllowSendingWithoutReply sets optional parameter allow_sending_without_reply.
+func (r *SendStickerRequest) WithAllowSendingWithoutReply(v bool) *SendStickerRequest {
+	r.AllowSendingWithoutReply = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *SendStickerRequest) WithReplyMarkup(v AnyKeyboard) *SendStickerRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// Silent sets disable_notification to true.
+func (r *SendStickerRequest) Silent() *SendStickerRequest {
+	r.DisableNotification = true
+	return r
+}
+
+// ReplyTo sets optional parameter reply_to_message_id.
+func (r *SendStickerRequest) ReplyTo(v int) *SendStickerRequest {
+	r.ReplyToMessageID = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *SendStickerRequest) Keyboard(v AnyKeyboard) *SendStickerRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewGetStickerSet creates GetStickerSetRequest with the required parameters.
+func NewGetStickerSet(name string) *GetStickerSetRequest {
+	return &GetStickerSetRequest{Name: name}
+}
+
+// NewUploadStickerFile creates UploadStickerFileRequest with the required parameters.
+func NewUploadStickerFile(userID int, pngSticker *InputFile) *UploadStickerFileRequest {
+	return &UploadStickerFileRequest{
+		PngSticker: pngSticker,
+		UserID:     userID,
+	}
+}
+
+// NewCreateNewStickerSet creates CreateNewStickerSetRequest with the required parameters.
+func NewCreateNewStickerSet(userID int, name string, title string, emojis string) *CreateNewStickerSetRequest {
+	return &CreateNewStickerSetRequest{
+		Emojis: emojis,
+		Name:   name,
+		Title:  title,
+		UserID: userID,
+	}
+}
+
+// WithPngSticker sets optional parameter png_sticker.
+func (r *CreateNewStickerSetRequest) WithPngSticker(v Fileable) *CreateNewStickerSetRequest {
+	r.PngSticker = v
+	return r
+}
+
+// WithTgsSticker sets optional parameter tgs_sticker.
+func (r *CreateNewStickerSetRequest) WithTgsSticker(v *InputFile) *CreateNewStickerSetRequest {
+	r.TgsSticker = v
+	return r
+}
+
+// WithContainsMasks sets optional parameter contains_masks.
+func (r *CreateNewStickerSetRequest) WithContainsMasks(v bool) *CreateNewStickerSetRequest {
+	r.ContainsMasks = v
+	return r
+}
+
+// WithMaskPosition sets optional parameter mask_position.
+func (r *CreateNewStickerSetRequest) WithMaskPosition(v *MaskPosition) *CreateNewStickerSetRequest {
+	r.MaskPosition = v
+	return r
+}
+
+// NewAddStickerToSet creates AddStickerToSetRequest with the required parameters.
+func NewAddStickerToSet(userID int, name string, emojis string) *AddStickerToSetRequest {
+	return &AddStickerToSetRequest{
+		Emojis: emojis,
+		Name:   name,
+		UserID: userID,
+	}
+}
+
+// WithPngSticker sets optional parameter png_sticker.
+func (r *AddStickerToSetRequest) WithPngSticker(v Fileable) *AddStickerToSetRequest {
+	r.PngSticker = v
+	return r
+}
+
+// WithTgsSticker sets optional parameter tgs_sticker.
+func (r *AddStickerToSetRequest) WithTgsSticker(v *InputFile) *AddStickerToSetRequest {
+	r.TgsSticker = v
+	return r
+}
+
+// WithMaskPosition sets optional parameter mask_position.
+func (r *AddStickerToSetRequest) WithMaskPosition(v *MaskPosition) *AddStickerToSetRequest {
+	r.MaskPosition = v
+	return r
+}
+
+// NewSetStickerPositionInSet creates SetStickerPositionInSetRequest with the required parameters.
+func NewSetStickerPositionInSet(sticker string, position int) *SetStickerPositionInSetRequest {
+	return &SetStickerPositionInSetRequest{
+		Position: position,
+		Sticker:  sticker,
+	}
+}
+
+// NewDeleteStickerFromSet creates DeleteStickerFromSetRequest with the required parameters.
+func NewDeleteStickerFromSet(sticker string) *DeleteStickerFromSetRequest {
+	return &DeleteStickerFromSetRequest{Sticker: sticker}
+}
+
+// NewSetStickerSetThumb creates SetStickerSetThumbRequest with the required parameters.
+func NewSetStickerSetThumb(name string, userID int) *SetStickerSetThumbRequest {
+	return &SetStickerSetThumbRequest{
+		Name:   name,
+		UserID: userID,
+	}
+}
+
+// WithThumb sets optional parameter thumb.
+func (r *SetStickerSetThumbRequest) WithThumb(v Fileable) *SetStickerSetThumbRequest {
+	r.Thumb = v
+	return r
+}
+
+// NewSetPassportDataErrors creates SetPassportDataErrorsRequest with the required parameters.
+func NewSetPassportDataErrors(userID int, errors []PassportElementError) *SetPassportDataErrorsRequest {
+	return &SetPassportDataErrorsRequest{
+		Errors: errors,
+		UserID: userID,
+	}
+}
+
+// NewEditMessageText creates EditMessageTextRequest with the required parameters.
+func NewEditMessageText(text string) *EditMessageTextRequest {
+	return &EditMessageTextRequest{Text: text}
+}
+
+// WithChatID sets optional parameter chat_id.
+func (r *EditMessageTextRequest) WithChatID(v string) *EditMessageTextRequest {
+	r.ChatID = v
+	return r
+}
+
+// WithMessageID sets optional parameter message_id.
+func (r *EditMessageTextRequest) WithMessageID(v int) *EditMessageTextRequest {
+	r.MessageID = v
+	return r
+}
+
+// WithInlineMessageID sets optional parameter inline_message_id.
+func (r *EditMessageTextRequest) WithInlineMessageID(v string) *EditMessageTextRequest {
+	r.InlineMessageID = v
+	return r
+}
+
+// WithParseMode sets optional parameter parse_mode.
+func (r *EditMessageTextRequest) WithParseMode(v ParseMode) *EditMessageTextRequest {
+	r.ParseMode = v
+	return r
+}
+
+// WithEntities sets optional parameter entities.
+func (r *EditMessageTextRequest) WithEntities(v []MessageEntity) *EditMessageTextRequest {
+	r.Entities = v
+	return r
+}
+
+// WithDisableWebPagePreview sets optional parameter disable_web_page_preview.
+func (r *EditMessageTextRequest) WithDisableWebPagePreview(v bool) *EditMessageTextRequest {
+	r.DisableWebPagePreview = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *EditMessageTextRequest) WithReplyMarkup(v *InlineKeyboardMarkup) *EditMessageTextRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// HTML sets parse_mode to ParseModeHTML.
+func (r *EditMessageTextRequest) HTML() *EditMessageTextRequest {
+	r.ParseMode = ParseModeHTML
+	return r
+}
+
+// MarkdownV2 sets parse_mode to ParseModeMarkdownV2.
+func (r *EditMessageTextRequest) MarkdownV2() *EditMessageTextRequest {
+	r.ParseMode = ParseModeMarkdownV2
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *EditMessageTextRequest) Keyboard(v *InlineKeyboardMarkup) *EditMessageTextRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewEditMessageCaption creates EditMessageCaptionRequest with the required parameters.
+func NewEditMessageCaption() *EditMessageCaptionRequest {
+	return &EditMessageCaptionRequest{}
+}
+
+// WithChatID sets optional parameter chat_id.
+func (r *EditMessageCaptionRequest) WithChatID(v string) *EditMessageCaptionRequest {
+	r.ChatID = v
+	return r
+}
+
+// WithMessageID sets optional parameter message_id.
+func (r *EditMessageCaptionRequest) WithMessageID(v int) *EditMessageCaptionRequest {
+	r.MessageID = v
+	return r
+}
+
+// WithInlineMessageID sets optional parameter inline_message_id.
+func (r *EditMessageCaptionRequest) WithInlineMessageID(v string) *EditMessageCaptionRequest {
+	r.InlineMessageID = v
+	return r
+}
+
+// WithCaption sets optional parameter caption.
+func (r *EditMessageCaptionRequest) WithCaption(v string) *EditMessageCaptionRequest {
+	r.Caption = v
+	return r
+}
+
+// WithParseMode sets optional parameter parse_mode.
+func (r *EditMessageCaptionRequest) WithParseMode(v ParseMode) *EditMessageCaptionRequest {
+	r.ParseMode = v
+	return r
+}
+
+// WithCaptionEntities sets optional parameter caption_entities.
+func (r *EditMessageCaptionRequest) WithCaptionEntities(v []MessageEntity) *EditMessageCaptionRequest {
+	r.CaptionEntities = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *EditMessageCaptionRequest) WithReplyMarkup(v *InlineKeyboardMarkup) *EditMessageCaptionRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// HTML sets parse_mode to ParseModeHTML.
+func (r *EditMessageCaptionRequest) HTML() *EditMessageCaptionRequest {
+	r.ParseMode = ParseModeHTML
+	return r
+}
+
+// MarkdownV2 sets parse_mode to ParseModeMarkdownV2.
+func (r *EditMessageCaptionRequest) MarkdownV2() *EditMessageCaptionRequest {
+	r.ParseMode = ParseModeMarkdownV2
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *EditMessageCaptionRequest) Keyboard(v *InlineKeyboardMarkup) *EditMessageCaptionRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewEditMessageMedia creates EditMessageMediaRequest with the required parameters.
+func NewEditMessageMedia(media *InputMedia) *EditMessageMediaRequest {
+	return &EditMessageMediaRequest{Media: media}
+}
+
+// WithChatID sets optional parameter chat_id.
+func (r *EditMessageMediaRequest) WithChatID(v string) *EditMessageMediaRequest {
+	r.ChatID = v
+	return r
+}
+
+// WithMessageID sets optional parameter message_id.
+func (r *EditMessageMediaRequest) WithMessageID(v int) *EditMessageMediaRequest {
+	r.MessageID = v
+	return r
+}
+
+// WithInlineMessageID sets optional parameter inline_message_id.
+func (r *EditMessageMediaRequest) WithInlineMessageID(v string) *EditMessageMediaRequest {
+	r.InlineMessageID = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *EditMessageMediaRequest) WithReplyMarkup(v *InlineKeyboardMarkup) *EditMessageMediaRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *EditMessageMediaRequest) Keyboard(v *InlineKeyboardMarkup) *EditMessageMediaRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewEditMessageReplyMarkup creates EditMessageReplyMarkupRequest with the required parameters.
+func NewEditMessageReplyMarkup() *EditMessageReplyMarkupRequest {
+	return &EditMessageReplyMarkupRequest{}
+}
+
+// WithChatID sets optional parameter chat_id.
+func (r *EditMessageReplyMarkupRequest) WithChatID(v string) *EditMessageReplyMarkupRequest {
+	r.ChatID = v
+	return r
+}
+
+// WithMessageID sets optional parameter message_id.
+func (r *EditMessageReplyMarkupRequest) WithMessageID(v int) *EditMessageReplyMarkupRequest {
+	r.MessageID = v
+	return r
+}
+
+// WithInlineMessageID sets optional parameter inline_message_id.
+func (r *EditMessageReplyMarkupRequest) WithInlineMessageID(v string) *EditMessageReplyMarkupRequest {
+	r.InlineMessageID = v
+	return r
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *EditMessageReplyMarkupRequest) WithReplyMarkup(v *InlineKeyboardMarkup) *EditMessageReplyMarkupRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *EditMessageReplyMarkupRequest) Keyboard(v *InlineKeyboardMarkup) *EditMessageReplyMarkupRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewStopPoll creates StopPollRequest with the required parameters.
+func NewStopPoll(chatID string, messageID int) *StopPollRequest {
+	return &StopPollRequest{
+		ChatID:    chatID,
+		MessageID: messageID,
+	}
+}
+
+// WithReplyMarkup sets optional parameter reply_markup.
+func (r *StopPollRequest) WithReplyMarkup(v *InlineKeyboardMarkup) *StopPollRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// Keyboard sets optional parameter reply_markup.
+func (r *StopPollRequest) Keyboard(v *InlineKeyboardMarkup) *StopPollRequest {
+	r.ReplyMarkup = v
+	return r
+}
+
+// NewDeleteMessage creates DeleteMessageRequest with the required parameters.
+func NewDeleteMessage(chatID string, messageID int) *DeleteMessageRequest {
+	return &DeleteMessageRequest{
+		ChatID:    chatID,
+		MessageID: messageID,
+	}
+}
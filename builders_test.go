@@ -0,0 +1,23 @@
+package telegram
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilders(t *testing.T) {
+	req := NewMessage("1", "<b>hi</b>").HTML().Silent().ReplyTo(5)
+	assert.Equal(t, &SendMessageRequest{
+		ChatID:              "1",
+		Text:                "<b>hi</b>",
+		ParseMode:           ParseModeHTML,
+		DisableNotification: true,
+		ReplyToMessageID:    5,
+	}, req)
+
+	j, err := json.Marshal(NewPoll("1", "?", []string{"a", "b"}).WithIsAnonymous(false))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"chat_id":"1","question":"?","options":["a","b"],"is_anonymous":false}`, string(j))
+}
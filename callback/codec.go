@@ -0,0 +1,321 @@
+package callback
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+// MaxDataLength is the limit of InlineKeyboardButton.CallbackData in bytes.
+const MaxDataLength = 64
+
+const (
+	separator = ':'
+	escape    = '\\'
+
+	// signatureLength is length of the truncated HMAC in bytes.
+	signatureLength = 6
+)
+
+var (
+	ErrTooLong          = errors.New("callback data is longer than 64 bytes")
+	ErrUnknownPrefix    = errors.New("unknown callback data prefix")
+	ErrInvalidSignature = errors.New("invalid callback data signature")
+	ErrInvalidData      = errors.New("invalid callback data")
+)
+
+// Codec encodes structs into compact callback data strings, like
+// "page:a:1", and decodes them back. Each registered type has a unique
+// prefix, exported fields are encoded in order of declaration. Supported
+// field kinds are strings, integers and booleans, fields with tag
+// `callback:"-"` are skipped.
+type Codec struct {
+	key      []byte
+	storage  *Storage
+	types    map[string]reflect.Type
+	prefixes map[reflect.Type]string
+}
+
+func NewCodec() *Codec {
+	return &Codec{
+		types:    make(map[string]reflect.Type),
+		prefixes: make(map[reflect.Type]string),
+	}
+}
+
+// NewSignedCodec returns codec, which appends HMAC signature to the data,
+// so that clients can't forge it. Signature takes 9 bytes.
+func NewSignedCodec(key []byte) *Codec {
+	c := NewCodec()
+	c.key = key
+	return c
+}
+
+func structType(v interface{}) (reflect.Type, error) {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("callback data must be a struct, got %T", v)
+	}
+
+	return t, nil
+}
+
+func encodedFields(t reflect.Type) []int {
+	var res []int
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" || f.Tag.Get("callback") == "-" {
+			continue
+		}
+		res = append(res, i)
+	}
+	return res
+}
+
+// SetStorage enables saving of the data, which is longer than 64 bytes,
+// to the storage. Such data is replaced with a short key.
+func (c *Codec) SetStorage(s *Storage) {
+	c.storage = s
+}
+
+// Register adds the struct type with the prefix. Value is used only to get the type.
+func (c *Codec) Register(prefix string, v interface{}) error {
+	if prefix == "" || strings.ContainsAny(prefix, string([]rune{separator, escape})) || isKey(prefix) {
+		return fmt.Errorf("invalid prefix %q", prefix)
+	}
+
+	t, err := structType(v)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.types[prefix]; ok {
+		return fmt.Errorf("prefix %q is already registered", prefix)
+	}
+	if _, ok := c.prefixes[t]; ok {
+		return fmt.Errorf("type %s is already registered", t)
+	}
+
+	for _, i := range encodedFields(t) {
+		f := t.Field(i)
+		switch f.Type.Kind() {
+		case reflect.String, reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		default:
+			return fmt.Errorf("field %s.%s has unsupported type %s", t, f.Name, f.Type)
+		}
+	}
+
+	c.types[prefix] = t
+	c.prefixes[t] = prefix
+	return nil
+}
+
+// MustRegister is like Register, but panics on error.
+func (c *Codec) MustRegister(prefix string, v interface{}) {
+	if err := c.Register(prefix, v); err != nil {
+		panic(err)
+	}
+}
+
+var escaper = strings.NewReplacer(string(escape), string([]rune{escape, escape}), string(separator), string([]rune{escape, separator}))
+
+func encodeField(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return escaper.Replace(v.String())
+	case reflect.Bool:
+		if v.Bool() {
+			return "1"
+		}
+		return ""
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 36)
+	default:
+		return strconv.FormatInt(v.Int(), 36)
+	}
+}
+
+func decodeField(v reflect.Value, s string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		v.SetBool(s != "")
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 36, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	default:
+		n, err := strconv.ParseInt(s, 36, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	}
+
+	return nil
+}
+
+func (c *Codec) sign(data string) string {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write([]byte(data))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:signatureLength])
+}
+
+// Encode returns callback data for the registered struct. ErrTooLong is
+// returned if the data doesn't fit into the button and storage is not set.
+func (c *Codec) Encode(v interface{}) (string, error) {
+	t, err := structType(v)
+	if err != nil {
+		return "", err
+	}
+
+	prefix, ok := c.prefixes[t]
+	if !ok {
+		return "", fmt.Errorf("type %s is not registered", t)
+	}
+
+	val := reflect.Indirect(reflect.ValueOf(v))
+	parts := []string{prefix}
+	for _, i := range encodedFields(t) {
+		parts = append(parts, encodeField(val.Field(i)))
+	}
+
+	data := strings.Join(parts, string(separator))
+	if len(c.key) != 0 {
+		data += string(separator) + c.sign(data)
+	}
+
+	if len(data) > MaxDataLength && c.storage != nil {
+		return c.storage.Data(data)
+	}
+
+	if len(data) > MaxDataLength {
+		return "", fmt.Errorf("%w: %q", ErrTooLong, data)
+	}
+
+	return data, nil
+}
+
+// MustEncode is like Encode, but panics on error.
+func (c *Codec) MustEncode(v interface{}) string {
+	data, err := c.Encode(v)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// Button returns inline button with the encoded data.
+func (c *Codec) Button(text string, v interface{}) (telegram.InlineKeyboardButton, error) {
+	data, err := c.Encode(v)
+	if err != nil {
+		return telegram.InlineKeyboardButton{}, err
+	}
+
+	return telegram.InlineKeyboardButton{
+		Text:         text,
+		CallbackData: data,
+	}, nil
+}
+
+// split splits data by unescaped separators, unescaping the parts.
+func split(data string) ([]string, error) {
+	var parts []string
+	var cur strings.Builder
+
+	escaped := false
+	for _, r := range data {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case r == escape:
+			escaped = true
+		case r == separator:
+			parts = append(parts, cur.String())
+			cur.Reset()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+
+	if escaped {
+		return nil, ErrInvalidData
+	}
+
+	return append(parts, cur.String()), nil
+}
+
+// Prefix returns prefix of the callback data, which identifies its type.
+func Prefix(data string) string {
+	if i := strings.IndexRune(data, separator); i >= 0 {
+		return data[:i]
+	}
+	return data
+}
+
+// Decode returns pointer to a new struct of the type, registered for the
+// data prefix. Use type switch to handle different types.
+func (c *Codec) Decode(data string) (interface{}, error) {
+	if c.storage != nil {
+		var err error
+		data, err = c.storage.Resolve(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t, ok := c.types[Prefix(data)]
+	if !ok {
+		return nil, ErrUnknownPrefix
+	}
+
+	if len(c.key) != 0 {
+		i := strings.LastIndexByte(data, separator)
+		if i < 0 || !hmac.Equal([]byte(data[i+1:]), []byte(c.sign(data[:i]))) {
+			return nil, ErrInvalidSignature
+		}
+		data = data[:i]
+	}
+
+	parts, err := split(data)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := encodedFields(t)
+	if len(parts) != len(fields)+1 {
+		return nil, ErrInvalidData
+	}
+
+	v := reflect.New(t)
+	for i, idx := range fields {
+		err := decodeField(v.Elem().Field(idx), parts[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: field %s: %v", ErrInvalidData, t.Field(idx).Name, err)
+		}
+	}
+
+	return v.Interface(), nil
+}
+
+// DecodeQuery decodes data of the callback query.
+func (c *Codec) DecodeQuery(q *telegram.CallbackQuery) (interface{}, error) {
+	return c.Decode(q.Data)
+}
@@ -0,0 +1,79 @@
+package callback
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type pageData struct {
+	List string
+	Page int
+	Desc bool
+}
+
+type deleteData struct {
+	ID      uint64
+	comment string
+	Skipped string `callback:"-"`
+}
+
+func TestCodec(t *testing.T) {
+	c := NewCodec()
+	c.MustRegister("p", pageData{})
+	c.MustRegister("del", &deleteData{})
+
+	assert.NotNil(t, c.Register("p", struct{}{}))
+	assert.NotNil(t, c.Register("x:y", struct{}{}))
+	assert.NotNil(t, c.Register("f", struct{ F float64 }{}))
+
+	data, err := c.Encode(pageData{List: "a:b\\c", Page: 100, Desc: true})
+	assert.Nil(t, err)
+	assert.Equal(t, `p:a\:b\\c:2s:1`, data)
+
+	v, err := c.Decode(data)
+	assert.Nil(t, err)
+	assert.Equal(t, &pageData{List: "a:b\\c", Page: 100, Desc: true}, v)
+
+	data, err = c.Encode(&deleteData{ID: 35, comment: "x", Skipped: "y"})
+	assert.Nil(t, err)
+	assert.Equal(t, "del:z", data)
+
+	v, err = c.Decode(data)
+	assert.Nil(t, err)
+	assert.Equal(t, &deleteData{ID: 35}, v)
+
+	_, err = c.Decode("unknown:1")
+	assert.Equal(t, ErrUnknownPrefix, err)
+
+	_, err = c.Decode("p:1")
+	assert.True(t, errors.Is(err, ErrInvalidData))
+
+	_, err = c.Encode(pageData{List: strings.Repeat("a", 60)})
+	assert.True(t, errors.Is(err, ErrTooLong))
+
+	_, err = c.Encode(struct{}{})
+	assert.NotNil(t, err)
+}
+
+func TestSignedCodec(t *testing.T) {
+	c := NewSignedCodec([]byte("secret"))
+	c.MustRegister("p", pageData{})
+
+	btn, err := c.Button("next", pageData{Page: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, "next", btn.Text)
+	assert.True(t, strings.HasPrefix(btn.CallbackData, "p::2::"))
+
+	v, err := c.Decode(btn.CallbackData)
+	assert.Nil(t, err)
+	assert.Equal(t, &pageData{Page: 2}, v)
+
+	forged := strings.Replace(btn.CallbackData, "p::2:", "p::3:", 1)
+	_, err = c.Decode(forged)
+	assert.Equal(t, ErrInvalidSignature, err)
+
+	assert.Equal(t, "p", Prefix(btn.CallbackData))
+}
@@ -0,0 +1,90 @@
+package callback
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+// keyPrefix marks callback data, which is a key in the store.
+const keyPrefix = "~"
+
+// keyLength is length of the random key in bytes.
+const keyLength = 9
+
+// Storage puts payloads, which don't fit into callback data, into the store
+// and uses short random keys instead.
+type Storage struct {
+	store Store
+	ttl   time.Duration
+}
+
+func NewStorage(store Store, ttl time.Duration) *Storage {
+	return &Storage{
+		store: store,
+		ttl:   ttl,
+	}
+}
+
+func isKey(data string) bool {
+	return strings.HasPrefix(data, keyPrefix)
+}
+
+// Data returns callback data for the payload. Short payloads are returned
+// as is, others are saved to the store.
+func (s *Storage) Data(payload string) (string, error) {
+	if len(payload) <= MaxDataLength && !isKey(payload) {
+		return payload, nil
+	}
+
+	b := make([]byte, keyLength)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	key := base64.RawURLEncoding.EncodeToString(b)
+	err = s.store.Set(key, payload, s.ttl)
+	if err != nil {
+		return "", err
+	}
+
+	return keyPrefix + key, nil
+}
+
+// Button returns inline button with the payload.
+func (s *Storage) Button(text string, payload string) (telegram.InlineKeyboardButton, error) {
+	data, err := s.Data(payload)
+	if err != nil {
+		return telegram.InlineKeyboardButton{}, err
+	}
+
+	return telegram.InlineKeyboardButton{
+		Text:         text,
+		CallbackData: data,
+	}, nil
+}
+
+// Resolve returns payload for callback data. ErrNotFound is returned for
+// expired keys.
+func (s *Storage) Resolve(data string) (string, error) {
+	if !isKey(data) {
+		return data, nil
+	}
+
+	return s.store.Get(strings.TrimPrefix(data, keyPrefix))
+}
+
+// ResolveQuery replaces data of the callback query with the payload.
+func (s *Storage) ResolveQuery(q *telegram.CallbackQuery) error {
+	payload, err := s.Resolve(q.Data)
+	if err != nil {
+		return err
+	}
+
+	q.Data = payload
+	return nil
+}
@@ -0,0 +1,140 @@
+package callback
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+func TestMemoryStore(t *testing.T) {
+	now := time.Now()
+	s := NewMemoryStore(2)
+	s.now = func() time.Time { return now }
+
+	assert.Nil(t, s.Set("a", "1", time.Minute))
+	assert.Nil(t, s.Set("b", "2", time.Hour))
+
+	v, err := s.Get("a")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", v)
+
+	// b is the least recently used
+	assert.Nil(t, s.Set("c", "3", time.Hour))
+	_, err = s.Get("b")
+	assert.Equal(t, ErrNotFound, err)
+
+	now = now.Add(2 * time.Minute)
+	_, err = s.Get("a")
+	assert.Equal(t, ErrNotFound, err)
+
+	v, err = s.Get("c")
+	assert.Nil(t, err)
+	assert.Equal(t, "3", v)
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "callback")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	s, err := NewFileStore(dir)
+	assert.Nil(t, err)
+	s.now = func() time.Time { return now }
+
+	assert.Nil(t, s.Set("a", "multi\nline", time.Minute))
+	assert.Nil(t, s.Set("b", "1", time.Hour))
+	assert.Nil(t, s.Set("b", "2", time.Hour))
+	assert.NotNil(t, s.Set("../a", "1", time.Hour))
+
+	v, err := s.Get("b")
+	assert.Nil(t, err)
+	assert.Equal(t, "2", v)
+
+	v, err = s.Get("a")
+	assert.Nil(t, err)
+	assert.Equal(t, "multi\nline", v)
+
+	_, err = s.Get("missing")
+	assert.Equal(t, ErrNotFound, err)
+
+	now = now.Add(2 * time.Minute)
+	assert.Nil(t, s.Cleanup())
+
+	// temporary files are renamed, only b is left
+	files, err := ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Len(t, files, 1)
+	assert.Equal(t, "b", files[0].Name())
+}
+
+func TestFileStoreCleanup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "callback")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	s, err := NewFileStore(dir)
+	assert.Nil(t, err)
+	s.now = func() time.Time { return now }
+
+	assert.Nil(t, s.Set("expired", "1", time.Minute))
+	assert.Nil(t, s.Set("valid", "2", time.Hour))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "corrupted"), []byte("garbage"), 0600))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "notes.txt"), []byte("foreign"), 0600))
+	assert.Nil(t, os.Mkdir(filepath.Join(dir, "subdir"), 0700))
+
+	now = now.Add(2 * time.Minute)
+	assert.Nil(t, s.Cleanup())
+
+	files, err := ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+
+	var names []string
+	for _, f := range files {
+		names = append(names, f.Name())
+	}
+	assert.Equal(t, []string{"notes.txt", "subdir", "valid"}, names)
+}
+
+func TestStorage(t *testing.T) {
+	s := NewStorage(NewMemoryStore(10), time.Hour)
+
+	data, err := s.Data("short")
+	assert.Nil(t, err)
+	assert.Equal(t, "short", data)
+
+	long := strings.Repeat("a", 100)
+	data, err = s.Data(long)
+	assert.Nil(t, err)
+	assert.True(t, len(data) <= MaxDataLength)
+
+	q := &telegram.CallbackQuery{Data: data}
+	assert.Nil(t, s.ResolveQuery(q))
+	assert.Equal(t, long, q.Data)
+
+	_, err = s.Resolve("~unknown")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestCodecStorage(t *testing.T) {
+	c := NewCodec()
+	c.MustRegister("p", pageData{})
+	c.SetStorage(NewStorage(NewMemoryStore(10), time.Hour))
+
+	page := &pageData{List: strings.Repeat("a", 100), Page: 1}
+	data, err := c.Encode(page)
+	assert.Nil(t, err)
+	assert.True(t, len(data) <= MaxDataLength)
+
+	v, err := c.Decode(data)
+	assert.Nil(t, err)
+	assert.Equal(t, page, v)
+}
@@ -0,0 +1,226 @@
+package callback
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNotFound is returned when the key is missing or expired.
+var ErrNotFound = errors.New("callback data not found or expired")
+
+var errCorrupted = errors.New("corrupted file")
+
+// Store keeps callback payloads, which don't fit into the button.
+type Store interface {
+	Set(key string, value string, ttl time.Duration) error
+	Get(key string) (string, error)
+}
+
+type memoryItem struct {
+	key     string
+	value   string
+	expires time.Time
+}
+
+// MemoryStore is LRU cache, which keeps at most capacity items.
+type MemoryStore struct {
+	capacity int
+	now      func() time.Time
+
+	mu    sync.Mutex
+	items map[string]*list.Element
+	order *list.List // front is the most recently used
+}
+
+func NewMemoryStore(capacity int) *MemoryStore {
+	return &MemoryStore{
+		capacity: capacity,
+		now:      time.Now,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (s *MemoryStore) Set(key string, value string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := &memoryItem{
+		key:     key,
+		value:   value,
+		expires: s.now().Add(ttl),
+	}
+
+	if el, ok := s.items[key]; ok {
+		el.Value = item
+		s.order.MoveToFront(el)
+		return nil
+	}
+
+	s.items[key] = s.order.PushFront(item)
+
+	for s.order.Len() > s.capacity {
+		last := s.order.Back()
+		s.order.Remove(last)
+		delete(s.items, last.Value.(*memoryItem).key)
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) Get(key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	el, ok := s.items[key]
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	item := el.Value.(*memoryItem)
+	if s.now().After(item.expires) {
+		s.order.Remove(el)
+		delete(s.items, key)
+		return "", ErrNotFound
+	}
+
+	s.order.MoveToFront(el)
+	return item.value, nil
+}
+
+// FileStore keeps each item in a separate file in the directory, so that
+// payloads survive restarts.
+type FileStore struct {
+	dir string
+	now func() time.Time
+}
+
+func NewFileStore(dir string) (*FileStore, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileStore{
+		dir: dir,
+		now: time.Now,
+	}, nil
+}
+
+var fileKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func (s *FileStore) path(key string) (string, error) {
+	if !fileKey.MatchString(key) {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+
+	return filepath.Join(s.dir, key), nil
+}
+
+func (s *FileStore) Set(key string, value string, ttl time.Duration) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	expires := strconv.FormatInt(s.now().Add(ttl).Unix(), 10)
+
+	// write to the temporary file first, to not leave a partial value
+	tmp := path + ".tmp"
+	err = ioutil.WriteFile(tmp, []byte(expires+"\n"+value), 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// read returns value and expiration time of the file.
+func (s *FileStore) read(path string) (string, time.Time, error) {
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", time.Time{}, ErrNotFound
+	}
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	parts := strings.SplitN(string(content), "\n", 2)
+	if len(parts) != 2 {
+		return "", time.Time{}, fmt.Errorf("%w %s", errCorrupted, path)
+	}
+
+	expires, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("%w %s: %v", errCorrupted, path, err)
+	}
+
+	return parts[1], time.Unix(expires, 0), nil
+}
+
+func (s *FileStore) Get(key string) (string, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return "", ErrNotFound
+	}
+
+	value, expires, err := s.read(path)
+	if err != nil {
+		return "", err
+	}
+
+	if s.now().After(expires) {
+		_ = os.Remove(path)
+		return "", ErrNotFound
+	}
+
+	return value, nil
+}
+
+// Cleanup removes expired and corrupted files of the store. Other files in
+// the directory are kept. Cleanup continues after errors and returns the
+// first of them.
+func (s *FileStore) Cleanup() error {
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, f := range files {
+		if f.IsDir() || !fileKey.MatchString(f.Name()) {
+			continue
+		}
+
+		path := filepath.Join(s.dir, f.Name())
+		_, expires, err := s.read(path)
+		if errors.Is(err, ErrNotFound) {
+			// removed concurrently
+			continue
+		}
+		if err != nil && !errors.Is(err, errCorrupted) {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		if err != nil || s.now().After(expires) {
+			err = os.Remove(path)
+			if err != nil && !os.IsNotExist(err) && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
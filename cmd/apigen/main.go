@@ -1,26 +1,87 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
 	"github.com/petuhovskiy/telegram/tools/apigen"
 	log "github.com/sirupsen/logrus"
-	"os"
 )
 
-func main() {
-	f, err := os.Open("api.html")
+const apiURL = "https://core.telegram.org/bots/api"
+
+var (
+	input   = flag.String("in", "api.html", "saved html page with the bot api documentation")
+	dest    = flag.String("dest", "../../", "directory for the generated code")
+	fetch   = flag.Bool("fetch", false, "download the latest documentation to -in, print changes and regenerate")
+	oldHTML = flag.String("diff", "", "print changes from the given old html to -in, without generating code")
+	schema  = flag.String("schema", "schema.json", "where to save json schema of the parsed api, empty to skip")
+	from    = flag.String("from-schema", "", "generate code from the given json schema instead of -in")
+)
+
+func parseFile(filename string) (*apigen.ParsedAPI, error) {
+	f, err := os.Open(filename)
 	if err != nil {
-		log.WithError(err).Fatal("failed to read protocol html")
+		return nil, err
 	}
 	defer f.Close()
 
-	p, err := apigen.Parse(f, apigen.DefaultParseOpts)
+	return apigen.Parse(f, apigen.DefaultParseOpts)
+}
+
+func readSchemaFile(filename string) (*apigen.ParsedAPI, error) {
+	f, err := os.Open(filename)
 	if err != nil {
-		log.WithError(err).Fatal("failed to parse protocol")
+		return nil, err
 	}
+	defer f.Close()
 
-	err = apigen.Codegen(p, &apigen.GenOpts{
+	s, err := apigen.ReadSchema(f)
+	if err != nil {
+		return nil, err
+	}
+
+	return apigen.ImportSchema(s)
+}
+
+func writeSchemaFile(filename string, p *apigen.ParsedAPI) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return apigen.WriteSchema(f, apigen.ExportSchema(p))
+}
+
+func download(filename string) error {
+	resp, err := http.Get(apiURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, resp.Body)
+	return err
+}
+
+func genOpts(dest string) *apigen.GenOpts {
+	return &apigen.GenOpts{
 		PackageName: "telegram",
-		Dest:        "../../",
+		Dest:        dest,
 		TypeExceptions: []apigen.TypeException{
 			{
 				Domain:     "",
@@ -52,6 +113,23 @@ func main() {
 				Method:       "getUpdates",
 				OverrideType: "[]Update",
 			},
+			{
+				Method:       "getMyCommands",
+				OverrideType: "[]BotCommand",
+			},
+			{
+				Method:       "answerShippingQuery",
+				OverrideType: "json.RawMessage",
+			},
+			{
+				Method:       "answerPreCheckoutQuery",
+				OverrideType: "json.RawMessage",
+			},
+			{
+				// media group items are not supported yet
+				Method: "sendMediaGroup",
+				Skip:   true,
+			},
 		},
 		StructExceptions: []apigen.StructException{
 			{
@@ -62,8 +140,136 @@ func main() {
 				StructName: "InputMessageContent",
 				Skip:       true,
 			},
+			{
+				StructName: "CallbackGame",
+				Skip:       true,
+			},
+		},
+		OptionalPointers: []string{
+			"ChatPermissions$",
+			"Poll$correct_option_id",
+			"KeyboardButtonPollType$type",
+			"sendPoll$is_anonymous",
+			"sendPoll$correct_option_id",
+			"answerInlineQuery$cache_time",
+		},
+		BuilderShortcuts: []apigen.BuilderShortcut{
+			{Field: "parse_mode", Name: "HTML", Value: "ParseModeHTML"},
+			{Field: "parse_mode", Name: "MarkdownV2", Value: "ParseModeMarkdownV2"},
+			{Field: "disable_notification", Name: "Silent", Value: "true"},
+			{Field: "reply_to_message_id", Name: "ReplyTo"},
+			{Field: "reply_markup", Name: "Keyboard"},
+		},
+		Enums: []apigen.Enum{
+			{
+				Name:   "ParseMode",
+				Fields: []string{"$parse_mode", "$explanation_parse_mode"},
+				Values: []string{"HTML", "MarkdownV2", "Markdown"},
+			},
+			{
+				Name:   "ChatType",
+				Fields: []string{"Chat$type"},
+			},
+			{
+				// also has "sender", which is not a type of Chat
+				Name:   "InlineQueryChatType",
+				Fields: []string{"InlineQuery$chat_type"},
+			},
+			{
+				Name:   "ChatAction",
+				Fields: []string{"sendChatAction$action"},
+			},
+			{
+				Name:   "ChatMemberStatus",
+				Fields: []string{"ChatMember$status"},
+			},
+			{
+				Name:   "MessageEntityType",
+				Fields: []string{"MessageEntity$type"},
+				// added in Bot API 5.6, not in the parsed documentation yet
+				Values: []string{"spoiler"},
+			},
+			{
+				Name:   "PollType",
+				Fields: []string{"Poll$type", "sendPoll$type"},
+			},
+			{
+				Name:   "DiceEmoji",
+				Fields: []string{"Dice$emoji", "sendDice$emoji"},
+				Names: map[string]string{
+					"🎲": "Dice",
+					"🎯": "Darts",
+					"🏀": "Basketball",
+					"⚽": "Football",
+					"🎳": "Bowling",
+					"🎰": "SlotMachine",
+				},
+			},
 		},
-	})
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	if *from != "" {
+		p, err := readSchemaFile(*from)
+		if err != nil {
+			log.WithError(err).Fatal("failed to read schema")
+		}
+
+		err = apigen.Codegen(p, genOpts(*dest))
+		if err != nil {
+			log.WithError(err).Fatal("failed to generate code")
+		}
+
+		return
+	}
+
+	var old *apigen.ParsedAPI
+
+	switch {
+	case *oldHTML != "":
+		p, err := parseFile(*oldHTML)
+		if err != nil {
+			log.WithError(err).Fatal("failed to parse old protocol")
+		}
+		old = p
+
+	case *fetch:
+		p, err := parseFile(*input)
+		if err != nil {
+			log.WithError(err).Warn("failed to parse current protocol, changes will not be printed")
+		}
+		old = p
+
+		err = download(*input)
+		if err != nil {
+			log.WithError(err).Fatal("failed to download protocol html")
+		}
+	}
+
+	p, err := parseFile(*input)
+	if err != nil {
+		log.WithError(err).Fatal("failed to parse protocol")
+	}
+
+	if old != nil {
+		fmt.Print(apigen.Diff(old, p))
+	}
+
+	if *oldHTML != "" {
+		return
+	}
+
+	if *schema != "" {
+		err = writeSchemaFile(*schema, p)
+		if err != nil {
+			log.WithError(err).Fatal("failed to write schema")
+		}
+	}
+
+	err = apigen.Codegen(p, genOpts(*dest))
 	if err != nil {
 		log.WithError(err).Fatal("failed to generate code")
 	}
@@ -1,11 +1,15 @@
 package main
 
 import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
 	"github.com/davecgh/go-spew/spew"
 	"github.com/petuhovskiy/telegram/tools/apigen"
 	"github.com/stretchr/testify/assert"
-	"os"
-	"testing"
 )
 
 func TestParse(t *testing.T) {
@@ -18,3 +22,51 @@ func TestParse(t *testing.T) {
 
 	assert.Nil(t, err)
 }
+
+func TestParseVersion(t *testing.T) {
+	f, err := os.Open("api.html")
+	assert.Nil(t, err)
+	defer f.Close()
+
+	p, err := apigen.Parse(f, apigen.DefaultParseOpts)
+	assert.Nil(t, err)
+	assert.Equal(t, "5.2", p.Version)
+}
+
+func TestSchemaRoundTrip(t *testing.T) {
+	p, err := parseFile("api.html")
+	assert.Nil(t, err)
+
+	var buf bytes.Buffer
+	err = apigen.WriteSchema(&buf, apigen.ExportSchema(p))
+	assert.Nil(t, err)
+
+	s, err := apigen.ReadSchema(&buf)
+	assert.Nil(t, err)
+
+	imported, err := apigen.ImportSchema(s)
+	assert.Nil(t, err)
+
+	generate := func(api *apigen.ParsedAPI) map[string]string {
+		dir, err := ioutil.TempDir("", "apigen")
+		assert.Nil(t, err)
+		defer os.RemoveAll(dir)
+
+		err = apigen.Codegen(api, genOpts(dir+"/"))
+		assert.Nil(t, err)
+
+		files, err := ioutil.ReadDir(dir)
+		assert.Nil(t, err)
+
+		res := make(map[string]string)
+		for _, f := range files {
+			data, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+			assert.Nil(t, err)
+			res[f.Name()] = string(data)
+		}
+
+		return res
+	}
+
+	assert.Equal(t, generate(p), generate(imported))
+}
@@ -0,0 +1,71 @@
+package command
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+// MaxPayloadLength is the limit of the deep link parameter.
+const MaxPayloadLength = 64
+
+var payloadRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]*$`)
+
+// ValidatePayload checks that the payload can be used in the deep link.
+func ValidatePayload(payload string) error {
+	if len(payload) > MaxPayloadLength {
+		return fmt.Errorf("payload is longer than %d characters", MaxPayloadLength)
+	}
+	if !payloadRegexp.MatchString(payload) {
+		return fmt.Errorf("payload %q contains characters other than A-Z, a-z, 0-9, _ and -", payload)
+	}
+	return nil
+}
+
+// EncodePayload encodes binary data with base64url, so that it can be used
+// as the deep link parameter.
+func EncodePayload(data []byte) (string, error) {
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload, ValidatePayload(payload)
+}
+
+// DecodePayload decodes data, encoded with EncodePayload.
+func DecodePayload(payload string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(payload)
+}
+
+func link(username, param, payload string) (string, error) {
+	err := ValidatePayload(payload)
+	if err != nil {
+		return "", err
+	}
+
+	u := url.URL{
+		Scheme:   "https",
+		Host:     "t.me",
+		Path:     "/" + username,
+		RawQuery: param + "=" + payload,
+	}
+	return u.String(), nil
+}
+
+// StartLink returns link, which opens private chat with the bot and sends
+// "/start payload".
+func StartLink(username, payload string) (string, error) {
+	return link(username, "start", payload)
+}
+
+// StartGroupLink returns link, which adds the bot to a group and sends
+// "/start payload" there.
+func StartGroupLink(username, payload string) (string, error) {
+	return link(username, "startgroup", payload)
+}
+
+// StartPayload returns deep link parameter of the /start command.
+func StartPayload(cmd *Command) (string, bool) {
+	if cmd.Name != "start" || cmd.RawArgs == "" {
+		return "", false
+	}
+	return cmd.RawArgs, true
+}
@@ -0,0 +1,137 @@
+package command
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+var (
+	ErrNoCommand     = errors.New("message doesn't start with a command")
+	ErrOtherBot      = errors.New("command is addressed to another bot")
+	ErrUnclosedQuote = errors.New("unclosed quote in arguments")
+)
+
+// Command is a parsed bot command, like "/start@my_bot arg".
+type Command struct {
+	// Name is the command without slash and bot username.
+	Name string
+
+	// Mention is the bot username from the command, empty if not specified.
+	Mention string
+
+	// RawArgs is the text after the command.
+	RawArgs string
+
+	Args []string
+}
+
+// Parser parses commands, which are addressed to the bot.
+type Parser struct {
+	username string
+}
+
+// NewParser returns parser for the bot with the username.
+func NewParser(username string) *Parser {
+	return &Parser{
+		username: strings.TrimPrefix(username, "@"),
+	}
+}
+
+// NewParserFromBot returns parser, which gets the username with GetMe.
+func NewParserFromBot(bot *telegram.Bot) (*Parser, error) {
+	me, err := bot.GetMe(&telegram.GetMeRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewParser(me.Username), nil
+}
+
+// Parse returns the command at the start of the message text, which is
+// found using the bot_command entity. ErrOtherBot is returned for commands
+// with username of another bot, which are sent in groups. ErrUnclosedQuote
+// is returned with the command, which has no Args.
+func (p *Parser) Parse(m *telegram.Message) (*Command, error) {
+	var entity *telegram.MessageEntity
+	for i, e := range m.Entities {
+		if e.Type == telegram.MessageEntityTypeBotCommand && e.Offset == 0 {
+			entity = &m.Entities[i]
+			break
+		}
+	}
+
+	if entity == nil {
+		return nil, ErrNoCommand
+	}
+
+	name := strings.TrimPrefix(telegram.EntityText(m.Text, *entity), "/")
+
+	cmd := &Command{
+		Name: name,
+	}
+	if i := strings.IndexByte(name, '@'); i >= 0 {
+		cmd.Name, cmd.Mention = name[:i], name[i+1:]
+	}
+
+	if cmd.Mention != "" && !strings.EqualFold(cmd.Mention, p.username) {
+		return nil, ErrOtherBot
+	}
+
+	cmd.RawArgs = strings.TrimLeftFunc(telegram.UTF16Slice(m.Text, entity.Length, telegram.UTF16Len(m.Text)), unicode.IsSpace)
+
+	// the command is returned with ErrUnclosedQuote, only its Args are not set
+	var err error
+	cmd.Args, err = ParseArgs(cmd.RawArgs)
+	return cmd, err
+}
+
+// ParseArgs splits text by spaces. Arguments with spaces can be quoted with
+// single or double quotes, backslash escapes the next character.
+func ParseArgs(text string) ([]string, error) {
+	var args []string
+	var cur strings.Builder
+
+	inArg := false
+	escaped := false
+	var quote rune
+
+	for _, r := range text {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+			inArg = true
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			cur.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, cur.String())
+				cur.Reset()
+				inArg = false
+			}
+		default:
+			cur.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, ErrUnclosedQuote
+	}
+
+	if inArg {
+		args = append(args, cur.String())
+	}
+
+	return args, nil
+}
@@ -0,0 +1,79 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+func commandMessage(text string, length int) *telegram.Message {
+	return &telegram.Message{
+		Text: text,
+		Entities: []telegram.MessageEntity{
+			{Type: telegram.MessageEntityTypeBotCommand, Offset: 0, Length: length},
+		},
+	}
+}
+
+func TestParse(t *testing.T) {
+	p := NewParser("@My_Bot")
+
+	cmd, err := p.Parse(commandMessage(`/add@my_bot  "buy milk" 😀 it\'s`, 11))
+	assert.Nil(t, err)
+	assert.Equal(t, &Command{
+		Name:    "add",
+		Mention: "my_bot",
+		RawArgs: `"buy milk" 😀 it\'s`,
+		Args:    []string{"buy milk", "😀", "it's"},
+	}, cmd)
+
+	cmd, err = p.Parse(commandMessage("/help", 5))
+	assert.Nil(t, err)
+	assert.Equal(t, "help", cmd.Name)
+	assert.Nil(t, cmd.Args)
+
+	_, err = p.Parse(commandMessage("/help@other_bot", 15))
+	assert.Equal(t, ErrOtherBot, err)
+
+	_, err = p.Parse(&telegram.Message{Text: "/help"})
+	assert.Equal(t, ErrNoCommand, err)
+
+	cmd, err = p.Parse(commandMessage(`/add "unclosed`, 4))
+	assert.Equal(t, ErrUnclosedQuote, err)
+	assert.Equal(t, "add", cmd.Name)
+	assert.Nil(t, cmd.Args)
+}
+
+func TestParseArgs(t *testing.T) {
+	args, err := ParseArgs(`a 'b c' "d ' e" "" f\ g`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b c", "d ' e", "", "f g"}, args)
+}
+
+func TestDeepLink(t *testing.T) {
+	payload, err := EncodePayload([]byte{0xff, 0xfe, 'a'})
+	assert.Nil(t, err)
+	assert.Equal(t, "__5h", payload)
+
+	link, err := StartLink("my_bot", payload)
+	assert.Nil(t, err)
+	assert.Equal(t, "https://t.me/my_bot?start=__5h", link)
+
+	_, err = StartGroupLink("my_bot", "a b")
+	assert.NotNil(t, err)
+
+	_, err = EncodePayload(make([]byte, 60))
+	assert.NotNil(t, err)
+
+	cmd, err := NewParser("my_bot").Parse(commandMessage("/start __5h", 6))
+	assert.Nil(t, err)
+
+	payload, ok := StartPayload(cmd)
+	assert.True(t, ok)
+
+	data, err := DecodePayload(payload)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0xff, 0xfe, 'a'}, data)
+}
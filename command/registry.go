@@ -0,0 +1,302 @@
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+const (
+	MaxNameLength        = 32
+	MinDescriptionLength = 3
+	MaxDescriptionLength = 256
+
+	// MaxCommands is the max count of commands in one scope and language.
+	MaxCommands = 100
+)
+
+var (
+	ErrInvalidName        = errors.New("command name must be 1-32 lowercase letters, digits or underscores")
+	ErrInvalidDescription = errors.New("command description must be 3-256 characters")
+	ErrDuplicateCommand   = errors.New("command is already registered")
+	ErrTooManyCommands    = errors.New("too many commands in the scope")
+)
+
+var nameRegexp = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+
+// Scope is the BotCommandScope, which defines users seeing the commands.
+type Scope struct {
+	Type   string `json:"type"`
+	ChatID string `json:"chat_id,omitempty"`
+	UserID int    `json:"user_id,omitempty"`
+}
+
+func ScopeDefault() Scope {
+	return Scope{Type: "default"}
+}
+
+func ScopeAllPrivateChats() Scope {
+	return Scope{Type: "all_private_chats"}
+}
+
+func ScopeAllGroupChats() Scope {
+	return Scope{Type: "all_group_chats"}
+}
+
+func ScopeAllChatAdministrators() Scope {
+	return Scope{Type: "all_chat_administrators"}
+}
+
+func ScopeChat(chatID string) Scope {
+	return Scope{Type: "chat", ChatID: chatID}
+}
+
+func ScopeChatAdministrators(chatID string) Scope {
+	return Scope{Type: "chat_administrators", ChatID: chatID}
+}
+
+func ScopeChatMember(chatID string, userID int) Scope {
+	return Scope{Type: "chat_member", ChatID: chatID, UserID: userID}
+}
+
+// Handler is called for the message with the registered command.
+type Handler func(m *telegram.Message, cmd *Command)
+
+// Entry is a command with its handler and the description for the menu.
+type Entry struct {
+	Name        string
+	Description string
+
+	// Scopes where the command is shown, the default scope if empty.
+	Scopes []Scope
+
+	// Languages are two-letter ISO 639-1 codes of users seeing the command,
+	// all users without a dedicated list if empty.
+	Languages []string
+
+	// Hidden commands are handled, but not shown in the menu.
+	Hidden bool
+
+	Handler Handler
+}
+
+// Validate checks the name and description limits.
+func (e *Entry) Validate() error {
+	if !nameRegexp.MatchString(e.Name) {
+		return fmt.Errorf("%w: %q", ErrInvalidName, e.Name)
+	}
+
+	if e.Hidden {
+		return nil
+	}
+
+	n := utf8.RuneCountInString(e.Description)
+	if n < MinDescriptionLength || n > MaxDescriptionLength {
+		return fmt.Errorf("%w: /%s", ErrInvalidDescription, e.Name)
+	}
+
+	return nil
+}
+
+// Registry dispatches commands to the handlers and keeps the bot command
+// list in sync with them.
+type Registry struct {
+	parser *Parser
+
+	mu      sync.RWMutex
+	entries []*Entry
+	byName  map[string]*Entry
+
+	// Fallback handles messages with unknown commands, optional.
+	Fallback Handler
+}
+
+func NewRegistry(parser *Parser) *Registry {
+	return &Registry{
+		parser: parser,
+		byName: make(map[string]*Entry),
+	}
+}
+
+// Register validates the entry and adds it to the registry.
+func (r *Registry) Register(e Entry) error {
+	err := e.Validate()
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.byName[e.Name]; ok {
+		return fmt.Errorf("%w: /%s", ErrDuplicateCommand, e.Name)
+	}
+
+	r.entries = append(r.entries, &e)
+	r.byName[e.Name] = &e
+	return nil
+}
+
+// MustRegister is like Register, but panics on error.
+func (r *Registry) MustRegister(e Entry) {
+	err := r.Register(e)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// HandleUpdate calls handler of the command in the message. Can be used
+// with updates.Router or Opts.HandleUpdate.
+func (r *Registry) HandleUpdate(update *telegram.Update) {
+	if update.Message == nil {
+		return
+	}
+
+	cmd, err := r.parser.Parse(update.Message)
+	if err == ErrNoCommand || err == ErrOtherBot {
+		return
+	}
+	if err != nil {
+		log.WithError(err).WithField("update_id", update.UpdateID).Error("failed to parse command")
+		return
+	}
+
+	r.mu.RLock()
+	e, ok := r.byName[cmd.Name]
+	r.mu.RUnlock()
+
+	switch {
+	case ok && e.Handler != nil:
+		e.Handler(update.Message, cmd)
+	case !ok && r.Fallback != nil:
+		r.Fallback(update.Message, cmd)
+	}
+}
+
+// commandList is the key of the list in SetMyCommands.
+type commandList struct {
+	scope    Scope
+	language string
+}
+
+// lists groups visible commands by scope and language, keys are in the
+// order of registration.
+func (r *Registry) lists() (map[commandList][]telegram.BotCommand, []commandList) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make(map[commandList][]telegram.BotCommand)
+	var keys []commandList
+
+	for _, e := range r.entries {
+		if e.Hidden {
+			continue
+		}
+
+		scopes := e.Scopes
+		if len(scopes) == 0 {
+			scopes = []Scope{ScopeDefault()}
+		}
+		languages := e.Languages
+		if len(languages) == 0 {
+			languages = []string{""}
+		}
+
+		for _, scope := range scopes {
+			for _, lang := range languages {
+				key := commandList{scope: scope, language: lang}
+				if _, ok := res[key]; !ok {
+					keys = append(keys, key)
+				}
+				res[key] = append(res[key], telegram.BotCommand{
+					Command:     e.Name,
+					Description: e.Description,
+				})
+			}
+		}
+	}
+
+	return res, keys
+}
+
+// getMyCommandsRequest has parameters from Bot API 5.3, which are missing
+// in the generated GetMyCommandsRequest.
+type getMyCommandsRequest struct {
+	Scope        *Scope `json:"scope,omitempty"`
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+type setMyCommandsRequest struct {
+	Commands     []telegram.BotCommand `json:"commands"`
+	Scope        *Scope                `json:"scope,omitempty"`
+	LanguageCode string                `json:"language_code,omitempty"`
+}
+
+func scopeParam(scope Scope) *Scope {
+	if scope == ScopeDefault() {
+		return nil
+	}
+	return &scope
+}
+
+func equalCommands(a, b []telegram.BotCommand) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Sync compares the registered commands with GetMyCommands for each used
+// scope and language, and calls SetMyCommands only for the changed lists.
+// Lists of scopes and languages without registered commands are not touched.
+func (r *Registry) Sync(bot *telegram.Bot) error {
+	lists, keys := r.lists()
+
+	for _, key := range keys {
+		commands := lists[key]
+		if len(commands) > MaxCommands {
+			return fmt.Errorf("%w: %s %q", ErrTooManyCommands, key.scope.Type, key.language)
+		}
+
+		j, err := bot.MakeRequest("getMyCommands", &getMyCommandsRequest{
+			Scope:        scopeParam(key.scope),
+			LanguageCode: key.language,
+		})
+		if err != nil {
+			return err
+		}
+
+		var current []telegram.BotCommand
+		err = json.Unmarshal(j, &current)
+		if err != nil {
+			return err
+		}
+
+		if equalCommands(current, commands) {
+			continue
+		}
+
+		_, err = bot.MakeRequest("setMyCommands", &setMyCommandsRequest{
+			Commands:     commands,
+			Scope:        scopeParam(key.scope),
+			LanguageCode: key.language,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
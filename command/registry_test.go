@@ -0,0 +1,94 @@
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/internal/telegramtest"
+)
+
+func TestRegistryValidate(t *testing.T) {
+	r := NewRegistry(NewParser("my_bot"))
+
+	assert.True(t, errors.Is(r.Register(Entry{Name: "Start", Description: "start bot"}), ErrInvalidName))
+	assert.True(t, errors.Is(r.Register(Entry{Name: "", Description: "start bot"}), ErrInvalidName))
+	assert.True(t, errors.Is(r.Register(Entry{Name: strings.Repeat("a", 33), Description: "start bot"}), ErrInvalidName))
+	assert.True(t, errors.Is(r.Register(Entry{Name: "start", Description: "go"}), ErrInvalidDescription))
+	assert.True(t, errors.Is(r.Register(Entry{Name: "start", Description: strings.Repeat("я", 257)}), ErrInvalidDescription))
+
+	assert.Nil(t, r.Register(Entry{Name: "start", Description: strings.Repeat("я", 256)}))
+	assert.Nil(t, r.Register(Entry{Name: "debug", Hidden: true}))
+	assert.True(t, errors.Is(r.Register(Entry{Name: "start", Description: "start bot"}), ErrDuplicateCommand))
+}
+
+func TestRegistryHandleUpdate(t *testing.T) {
+	r := NewRegistry(NewParser("my_bot"))
+
+	var called []string
+	r.MustRegister(Entry{
+		Name:        "add",
+		Description: "add item",
+		Handler: func(m *telegram.Message, cmd *Command) {
+			called = append(called, cmd.Name+" "+strings.Join(cmd.Args, ","))
+		},
+	})
+	r.Fallback = func(m *telegram.Message, cmd *Command) {
+		called = append(called, "unknown "+cmd.Name)
+	}
+
+	r.HandleUpdate(&telegram.Update{Message: commandMessage("/add a b", 4)})
+	r.HandleUpdate(&telegram.Update{Message: commandMessage("/del a", 4)})
+	r.HandleUpdate(&telegram.Update{Message: commandMessage("/add@other_bot", 14)})
+	r.HandleUpdate(&telegram.Update{Message: &telegram.Message{Text: "add"}})
+
+	assert.Equal(t, []string{"add a,b", "unknown del"}, called)
+}
+
+func TestRegistrySync(t *testing.T) {
+	current := map[string]string{
+		`{}`: `[{"command":"start","description":"start bot"},{"command":"help","description":"show help"}]`,
+	}
+
+	rec := &telegramtest.Recorder{
+		Handler: func(method string, req interface{}) (json.RawMessage, error) {
+			if method != "getMyCommands" {
+				return telegramtest.DefaultResponse(method, req)
+			}
+
+			body, _ := json.Marshal(req)
+			if resp, ok := current[string(body)]; ok {
+				return json.RawMessage(resp), nil
+			}
+			return json.RawMessage(`[]`), nil
+		},
+	}
+
+	r := NewRegistry(NewParser("my_bot"))
+	r.MustRegister(Entry{Name: "start", Description: "start bot"})
+	r.MustRegister(Entry{Name: "help", Description: "show help"})
+	r.MustRegister(Entry{Name: "debug", Hidden: true})
+	r.MustRegister(Entry{
+		Name:        "ban",
+		Description: "забанить",
+		Scopes:      []Scope{ScopeChatAdministrators("-100")},
+		Languages:   []string{"ru"},
+	})
+
+	assert.Nil(t, r.Sync(rec.Bot()))
+
+	var requests []string
+	for _, req := range rec.Requests() {
+		body, _ := json.Marshal(req.Req)
+		requests = append(requests, req.Method+" "+string(body))
+	}
+	assert.Equal(t, []string{
+		`getMyCommands {}`,
+		`getMyCommands {"scope":{"type":"chat_administrators","chat_id":"-100"},"language_code":"ru"}`,
+		`setMyCommands {"commands":[{"command":"ban","description":"забанить"}],"scope":{"type":"chat_administrators","chat_id":"-100"},"language_code":"ru"}`,
+	}, requests)
+}
@@ -0,0 +1,309 @@
+package conversation
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/command"
+)
+
+// Scope defines whose state is kept in the session.
+type Scope int
+
+const (
+	// PerChat shares the conversation between all users of the chat.
+	PerChat Scope = iota
+	// PerUser shares the conversation of the user between all chats.
+	PerUser
+	// PerChatUser keeps separate conversation for each user in each chat.
+	PerChatUser
+)
+
+// Context is passed to the step handlers.
+type Context struct {
+	Bot     *telegram.Bot
+	Update  *telegram.Update
+	Session *Session
+
+	// ChatID is the chat of the message or the callback query.
+	ChatID string
+
+	// Text is the message text or the callback data.
+	Text string
+
+	ended bool
+	next  bool
+}
+
+// Next sets the state, which handles the next update.
+func (c *Context) Next(state string) {
+	c.Session.State = state
+	c.next = true
+}
+
+// End finishes the conversation and deletes the session.
+func (c *Context) End() {
+	c.ended = true
+}
+
+// Set saves the value to the session.
+func (c *Context) Set(key, value string) {
+	if c.Session.Data == nil {
+		c.Session.Data = make(map[string]string)
+	}
+	c.Session.Data[key] = value
+}
+
+// Get returns the value from the session.
+func (c *Context) Get(key string) string {
+	return c.Session.Data[key]
+}
+
+// Reply sends text to the chat.
+func (c *Context) Reply(text string) error {
+	_, err := c.Bot.SendMessage(&telegram.SendMessageRequest{
+		ChatID: c.ChatID,
+		Text:   text,
+	})
+	return err
+}
+
+type StepHandler func(ctx *Context) error
+
+// Conversation is a state machine, which handles messages and callback
+// queries according to the state of the session.
+type Conversation struct {
+	bot   *telegram.Bot
+	store SessionStore
+	now   func() time.Time
+
+	entries map[string]StepHandler
+	steps   map[string]StepHandler
+
+	locksMu sync.Mutex
+	locks   map[string]*keyLock
+
+	Scope Scope
+
+	// Username of the bot. Commands with the username, like /cancel@my_bot,
+	// are accepted only if it's set, commands to other bots are ignored.
+	Username string
+
+	// Timeout ends the conversation, if there were no updates for the
+	// duration. It's checked on the next update, zero means no timeout.
+	Timeout time.Duration
+
+	// CancelCommands end the conversation at any step.
+	CancelCommands []string
+
+	// OnCancel and OnTimeout are called before the session is deleted, optional.
+	OnCancel  StepHandler
+	OnTimeout StepHandler
+
+	// Fallback handles updates outside of the conversation, optional.
+	Fallback func(update *telegram.Update)
+}
+
+func New(bot *telegram.Bot, store SessionStore) *Conversation {
+	return &Conversation{
+		bot:            bot,
+		store:          store,
+		now:            time.Now,
+		entries:        make(map[string]StepHandler),
+		steps:          make(map[string]StepHandler),
+		locks:          make(map[string]*keyLock),
+		CancelCommands: []string{"/cancel"},
+	}
+}
+
+// Entry sets handler of the command, like "/register", which starts the
+// conversation. The handler should set the next state, otherwise the session
+// is deleted.
+func (c *Conversation) Entry(cmd string, h StepHandler) {
+	c.entries[cmd] = h
+}
+
+// Step sets handler of the state. The session is deleted, if the handler
+// doesn't set the next state.
+func (c *Conversation) Step(state string, h StepHandler) {
+	c.steps[state] = h
+}
+
+// messageCommand returns the command of the message with the leading slash, like
+// "/register". Empty string is returned for commands to other bots.
+func messageCommand(m *telegram.Message, username string) string {
+	cmd, err := command.NewParser(username).Parse(m)
+	if err != nil && err != command.ErrUnclosedQuote {
+		return ""
+	}
+	return "/" + cmd.Name
+}
+
+func (c *Conversation) key(chat *telegram.Chat, user *telegram.User) string {
+	switch {
+	case c.Scope == PerChat && chat != nil:
+		return strconv.Itoa(chat.ID)
+	case c.Scope == PerUser && user != nil:
+		return strconv.Itoa(user.ID)
+	case c.Scope == PerChatUser && chat != nil && user != nil:
+		return strconv.Itoa(chat.ID) + "_" + strconv.Itoa(user.ID)
+	}
+
+	return ""
+}
+
+// keyLock is the lock of the session, it's deleted when nobody holds or
+// waits for it.
+type keyLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+func (c *Conversation) lock(key string) func() {
+	c.locksMu.Lock()
+	l, ok := c.locks[key]
+	if !ok {
+		l = &keyLock{}
+		c.locks[key] = l
+	}
+	l.refs++
+	c.locksMu.Unlock()
+
+	l.mu.Lock()
+	return func() {
+		l.mu.Unlock()
+
+		c.locksMu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(c.locks, key)
+		}
+		c.locksMu.Unlock()
+	}
+}
+
+// newContext returns context for messages and callback queries, nil for other updates.
+func (c *Conversation) newContext(update *telegram.Update) (*Context, string) {
+	var chat *telegram.Chat
+	var user *telegram.User
+	ctx := &Context{
+		Bot:    c.bot,
+		Update: update,
+	}
+
+	switch {
+	case update.Message != nil:
+		chat, user = update.Message.Chat, update.Message.From
+		ctx.Text = update.Message.Text
+	case update.CallbackQuery != nil && update.CallbackQuery.Message != nil:
+		chat, user = update.CallbackQuery.Message.Chat, update.CallbackQuery.From
+		ctx.Text = update.CallbackQuery.Data
+	default:
+		return nil, ""
+	}
+
+	if chat != nil {
+		ctx.ChatID = strconv.Itoa(chat.ID)
+	}
+
+	return ctx, c.key(chat, user)
+}
+
+func (c *Conversation) isCancel(cmd string) bool {
+	for _, v := range c.CancelCommands {
+		if v == cmd {
+			return true
+		}
+	}
+	return false
+}
+
+// Process handles the update and reports whether it belongs to the conversation.
+func (c *Conversation) Process(update *telegram.Update) (bool, error) {
+	ctx, key := c.newContext(update)
+	if ctx == nil || key == "" {
+		return false, nil
+	}
+
+	defer c.lock(key)()
+
+	session, err := c.store.Get(key)
+	if err != nil {
+		return false, err
+	}
+
+	cmd := ""
+	if update.Message != nil {
+		cmd = messageCommand(update.Message, c.Username)
+	}
+
+	if session != nil && c.Timeout != 0 && c.now().Sub(session.UpdatedAt) > c.Timeout {
+		ctx.Session = session
+		err := c.finish(ctx, key, c.OnTimeout)
+		if err != nil {
+			return true, err
+		}
+		session = nil
+	}
+
+	if session != nil && c.isCancel(cmd) {
+		ctx.Session = session
+		return true, c.finish(ctx, key, c.OnCancel)
+	}
+
+	var h StepHandler
+	if entry, ok := c.entries[cmd]; ok {
+		// entry command restarts the conversation
+		session = &Session{}
+		h = entry
+	} else if session != nil {
+		h = c.steps[session.State]
+	}
+
+	if h == nil {
+		return false, nil
+	}
+
+	ctx.Session = session
+	err = h(ctx)
+	if err != nil {
+		return true, err
+	}
+
+	if ctx.ended || !ctx.next || session.State == "" {
+		// the step didn't set the next state
+		return true, c.store.Delete(key)
+	}
+
+	session.UpdatedAt = c.now()
+	return true, c.store.Set(key, session)
+}
+
+// finish calls the handler and deletes the session.
+func (c *Conversation) finish(ctx *Context, key string, h StepHandler) error {
+	if h != nil {
+		err := h(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.store.Delete(key)
+}
+
+// HandleUpdate processes the update, updates outside of the conversation are
+// passed to the Fallback. Can be used with updates.Router or Opts.HandleUpdate.
+func (c *Conversation) HandleUpdate(update *telegram.Update) {
+	handled, err := c.Process(update)
+	if err != nil {
+		log.WithError(err).WithField("update_id", update.UpdateID).Error("failed to process conversation update")
+	}
+
+	if !handled && c.Fallback != nil {
+		c.Fallback(update)
+	}
+}
@@ -0,0 +1,217 @@
+package conversation
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/internal/telegramtest"
+)
+
+// sentTexts returns texts of the sent messages and resets the recorder.
+func sentTexts(rec *telegramtest.Recorder) []string {
+	var res []string
+	for _, r := range rec.Requests() {
+		res = append(res, r.Req.(*telegram.SendMessageRequest).Text)
+	}
+	rec.Reset()
+	return res
+}
+
+func message(chatID int, userID int, text string) *telegram.Update {
+	m := &telegram.Message{
+		Chat: &telegram.Chat{ID: chatID},
+		From: &telegram.User{ID: userID},
+		Text: text,
+	}
+
+	if strings.HasPrefix(text, "/") {
+		m.Entities = []telegram.MessageEntity{
+			{Type: telegram.MessageEntityTypeBotCommand, Length: telegram.UTF16Len(strings.Fields(text)[0])},
+		}
+	}
+
+	return &telegram.Update{Message: m}
+}
+
+func registration(bot *telegram.Bot, store SessionStore) *Conversation {
+	c := New(bot, store)
+	c.Username = "test_bot"
+	c.Entry("/register", func(ctx *Context) error {
+		ctx.Next("name")
+		return ctx.Reply("What is your name?")
+	})
+	c.Step("name", func(ctx *Context) error {
+		ctx.Set("name", ctx.Text)
+		ctx.Next("phone")
+		return ctx.Reply("Phone?")
+	})
+	c.Step("phone", func(ctx *Context) error {
+		ctx.End()
+		return ctx.Reply(ctx.Get("name") + ", " + ctx.Text)
+	})
+	c.OnCancel = func(ctx *Context) error {
+		return ctx.Reply("Cancelled")
+	}
+	c.OnTimeout = func(ctx *Context) error {
+		return ctx.Reply("Timeout")
+	}
+	return c
+}
+
+func TestConversation(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	store := NewMemoryStore()
+	c := registration(rec.Bot(), store)
+
+	var fallback []string
+	c.Fallback = func(update *telegram.Update) {
+		fallback = append(fallback, update.Message.Text)
+	}
+
+	c.HandleUpdate(message(1, 10, "hello"))
+	c.HandleUpdate(message(1, 10, "/register@test_bot"))
+	c.HandleUpdate(message(1, 10, "John"))
+	c.HandleUpdate(message(1, 10, "+123"))
+	c.HandleUpdate(message(1, 10, "bye"))
+
+	assert.Equal(t, []string{"What is your name?", "Phone?", "John, +123"}, sentTexts(rec))
+	assert.Equal(t, []string{"hello", "bye"}, fallback)
+
+	session, err := store.Get("1")
+	assert.Nil(t, err)
+	assert.Nil(t, session)
+
+	c.HandleUpdate(message(1, 10, "/register"))
+	// command to another bot is the answer to the step
+	c.HandleUpdate(message(1, 10, "/cancel@other_bot"))
+	c.HandleUpdate(message(1, 10, "/cancel"))
+	assert.Equal(t, []string{"What is your name?", "Phone?", "Cancelled"}, sentTexts(rec))
+
+	c.HandleUpdate(message(1, 10, "/register@other_bot"))
+	assert.Nil(t, sentTexts(rec))
+
+	// text without bot_command entity is not a command
+	c.HandleUpdate(&telegram.Update{Message: &telegram.Message{
+		Chat: &telegram.Chat{ID: 1},
+		From: &telegram.User{ID: 10},
+		Text: "/register",
+	}})
+	assert.Nil(t, sentTexts(rec))
+	assert.Equal(t, []string{"hello", "bye", "/register@other_bot", "/register"}, fallback)
+
+	// locks are deleted after the updates are processed
+	assert.Empty(t, c.locks)
+}
+
+func TestConversationNoNextState(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	store := NewMemoryStore()
+	c := registration(rec.Bot(), store)
+	c.Step("name", func(ctx *Context) error {
+		return ctx.Reply("Bye, " + ctx.Text)
+	})
+
+	c.HandleUpdate(message(1, 10, "/register"))
+	c.HandleUpdate(message(1, 10, "John"))
+
+	session, err := store.Get("1")
+	assert.Nil(t, err)
+	assert.Nil(t, session)
+
+	handled, err := c.Process(message(1, 10, "John"))
+	assert.Nil(t, err)
+	assert.False(t, handled)
+	assert.Equal(t, []string{"What is your name?", "Bye, John"}, sentTexts(rec))
+}
+
+func TestConversationTimeout(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	now := time.Now()
+
+	c := registration(rec.Bot(), NewMemoryStore())
+	c.Scope = PerChatUser
+	c.Timeout = time.Minute
+	c.now = func() time.Time { return now }
+
+	c.HandleUpdate(message(1, 10, "/register"))
+	// other user in the same chat
+	handled, err := c.Process(message(1, 11, "Alice"))
+	assert.Nil(t, err)
+	assert.False(t, handled)
+
+	now = now.Add(2 * time.Minute)
+	// expired conversation doesn't handle the message
+	handled, err = c.Process(message(1, 10, "John"))
+	assert.Nil(t, err)
+	assert.False(t, handled)
+	assert.Equal(t, []string{"What is your name?", "Timeout"}, sentTexts(rec))
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "conversation")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := NewFileStore(dir)
+	assert.Nil(t, err)
+
+	session, err := store.Get("-100_1")
+	assert.Nil(t, err)
+	assert.Nil(t, session)
+
+	expected := &Session{State: "name", Data: map[string]string{"a": "b"}, UpdatedAt: time.Unix(100, 0).UTC()}
+	assert.Nil(t, store.Set("-100_1", expected))
+
+	session, err = store.Get("-100_1")
+	assert.Nil(t, err)
+	assert.Equal(t, expected, session)
+
+	assert.Nil(t, store.Delete("-100_1"))
+	assert.Nil(t, store.Delete("-100_1"))
+	assert.NotNil(t, store.Set("../x", expected))
+}
+
+func TestBoltStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "conversation")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := bolt.Open(filepath.Join(dir, "bot.db"), 0600, nil)
+	assert.Nil(t, err)
+	defer db.Close()
+
+	store, err := NewBoltStore(db, "sessions")
+	assert.Nil(t, err)
+
+	session, err := store.Get("-100_1")
+	assert.Nil(t, err)
+	assert.Nil(t, session)
+
+	expected := &Session{State: "name", Data: map[string]string{"a": "b"}, UpdatedAt: time.Unix(100, 0).UTC()}
+	assert.Nil(t, store.Set("-100_1", expected))
+
+	session, err = store.Get("-100_1")
+	assert.Nil(t, err)
+	assert.Equal(t, expected, session)
+
+	// the bucket is kept, when the store is created again
+	store, err = NewBoltStore(db, "sessions")
+	assert.Nil(t, err)
+	session, err = store.Get("-100_1")
+	assert.Nil(t, err)
+	assert.Equal(t, expected, session)
+
+	assert.Nil(t, store.Delete("-100_1"))
+	assert.Nil(t, store.Delete("-100_1"))
+	session, err = store.Get("-100_1")
+	assert.Nil(t, err)
+	assert.Nil(t, session)
+}
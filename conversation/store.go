@@ -0,0 +1,231 @@
+package conversation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Session is the state of the conversation in one chat.
+type Session struct {
+	State     string            `json:"state"`
+	Data      map[string]string `json:"data,omitempty"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// SessionStore keeps sessions between updates. Get returns nil session if
+// there is no conversation. Stores backed by other databases, like redis,
+// can be implemented outside of the package.
+type SessionStore interface {
+	Get(key string) (*Session, error)
+	Set(key string, s *Session) error
+	Delete(key string) error
+}
+
+type MemoryStore struct {
+	mu       sync.Mutex
+	sessions map[string]Session
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		sessions: make(map[string]Session),
+	}
+}
+
+func copyData(data map[string]string) map[string]string {
+	if data == nil {
+		return nil
+	}
+
+	res := make(map[string]string, len(data))
+	for k, v := range data {
+		res[k] = v
+	}
+	return res
+}
+
+func (s *MemoryStore) Get(key string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[key]
+	if !ok {
+		return nil, nil
+	}
+
+	session.Data = copyData(session.Data)
+	return &session, nil
+}
+
+func (s *MemoryStore) Set(key string, session *Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	copied := *session
+	copied.Data = copyData(session.Data)
+	s.sessions[key] = copied
+	return nil
+}
+
+func (s *MemoryStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, key)
+	return nil
+}
+
+// FileStore keeps each session in a json file in the directory.
+type FileStore struct {
+	dir string
+}
+
+func NewFileStore(dir string) (*FileStore, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileStore{
+		dir: dir,
+	}, nil
+}
+
+var fileKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func (s *FileStore) path(key string) (string, error) {
+	if !fileKey.MatchString(key) {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+
+	return filepath.Join(s.dir, key+".json"), nil
+}
+
+func (s *FileStore) Get(key string) (*Session, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var session Session
+	err = json.Unmarshal(content, &session)
+	if err != nil {
+		return nil, fmt.Errorf("corrupted session %s: %w", path, err)
+	}
+
+	return &session, nil
+}
+
+func (s *FileStore) Set(key string, session *Session) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	// write to the temporary file first, to not leave a partial session
+	tmp := path + ".tmp"
+	err = ioutil.WriteFile(tmp, content, 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+func (s *FileStore) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// BoltStore keeps sessions as json in the bucket of bbolt database.
+type BoltStore struct {
+	db     *bolt.DB
+	bucket []byte
+}
+
+// NewBoltStore creates the bucket, if it doesn't exist. The database can be
+// shared with other data of the bot, it's not closed by the store.
+func NewBoltStore(db *bolt.DB, bucket string) (*BoltStore, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BoltStore{
+		db:     db,
+		bucket: []byte(bucket),
+	}, nil
+}
+
+func (s *BoltStore) Get(key string) (*Session, error) {
+	var content []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		// the value is valid only inside the transaction
+		content = append(content, tx.Bucket(s.bucket).Get([]byte(key))...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if content == nil {
+		return nil, nil
+	}
+
+	var session Session
+	err = json.Unmarshal(content, &session)
+	if err != nil {
+		return nil, fmt.Errorf("corrupted session %s: %w", key, err)
+	}
+
+	return &session, nil
+}
+
+func (s *BoltStore) Set(key string, session *Session) error {
+	content, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(s.bucket).Put([]byte(key), content)
+	})
+}
+
+func (s *BoltStore) Delete(key string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(s.bucket).Delete([]byte(key))
+	})
+}
@@ -0,0 +1,281 @@
+package telegram
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	// MaxMessageLength is the limit of message text length in UTF-16 code units.
+	MaxMessageLength = 4096
+
+	// MaxCaptionLength is the limit of media caption length in UTF-16 code units.
+	MaxCaptionLength = 1024
+)
+
+// UTF16Len returns length of the string in UTF-16 code units, as telegram counts characters.
+func UTF16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		if r >= 0x10000 {
+			// encoded as surrogate pair
+			n += 2
+		} else {
+			n++
+		}
+	}
+	return n
+}
+
+// UTF16Slice returns substring, using offset and length in UTF-16 code units.
+// Out of range values are clipped.
+func UTF16Slice(s string, offset, length int) string {
+	u := utf16.Encode([]rune(s))
+	start, end := clip(offset, offset+length, len(u))
+	return string(utf16.Decode(u[start:end]))
+}
+
+func clip(start, end, n int) (int, int) {
+	if start < 0 {
+		start = 0
+	}
+	if end > n {
+		end = n
+	}
+	if start > end {
+		start = end
+	}
+	return start, end
+}
+
+// FormattedText is a text with entities, like Message.Text and Message.Entities.
+type FormattedText struct {
+	Text     string
+	Entities []MessageEntity
+}
+
+// EntityText returns part of the text, covered by the entity.
+func EntityText(text string, e MessageEntity) string {
+	return UTF16Slice(text, e.Offset, e.Length)
+}
+
+// FormattedText returns message text with entities, or caption with caption
+// entities for media messages.
+func (m *Message) FormattedText() FormattedText {
+	if m.Text == "" && m.Caption != "" {
+		return FormattedText{
+			Text:     m.Caption,
+			Entities: m.CaptionEntities,
+		}
+	}
+
+	return FormattedText{
+		Text:     m.Text,
+		Entities: m.Entities,
+	}
+}
+
+// EntityText returns part of the message text or caption, covered by the entity.
+func (m *Message) EntityText(e MessageEntity) string {
+	return EntityText(m.FormattedText().Text, e)
+}
+
+// ShiftEntities returns copy of entities, moved by offset in UTF-16 code units.
+func ShiftEntities(entities []MessageEntity, offset int) []MessageEntity {
+	if entities == nil {
+		return nil
+	}
+
+	res := make([]MessageEntity, len(entities))
+	for i, e := range entities {
+		e.Offset += offset
+		res[i] = e
+	}
+
+	return res
+}
+
+func sameEntity(a, b MessageEntity) bool {
+	return a.Type == b.Type && a.URL == b.URL && sameUser(a.User, b.User) && a.Language == b.Language
+}
+
+// sameUser compares users by ID, entities of different texts have different
+// pointers to the same user.
+func sameUser(a, b *User) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.ID == b.ID
+}
+
+// MergeEntities sorts entities and joins equal entities, which overlap or
+// touch each other, e.g. bold at the end of one text and bold at the start
+// of the concatenated text.
+func MergeEntities(entities []MessageEntity) []MessageEntity {
+	sorted := append([]MessageEntity(nil), entities...)
+	sortEntities(sorted)
+
+	var res []MessageEntity
+	for _, e := range sorted {
+		merged := false
+		for i := len(res) - 1; i >= 0; i-- {
+			prev := &res[i]
+			if sameEntity(*prev, e) && e.Offset <= prev.Offset+prev.Length {
+				if end := e.Offset + e.Length; end > prev.Offset+prev.Length {
+					prev.Length = end - prev.Offset
+				}
+				merged = true
+				break
+			}
+		}
+
+		if !merged {
+			res = append(res, e)
+		}
+	}
+
+	return res
+}
+
+// Concat concatenates texts, shifting and merging their entities.
+func Concat(parts ...FormattedText) FormattedText {
+	var res FormattedText
+	offset := 0
+
+	for _, p := range parts {
+		res.Text += p.Text
+		res.Entities = append(res.Entities, ShiftEntities(p.Entities, offset)...)
+		offset += UTF16Len(p.Text)
+	}
+
+	res.Entities = MergeEntities(res.Entities)
+	return res
+}
+
+// splitSeparators are preferred boundaries of the parts, from the best to the worst.
+// Empty separator means any position.
+var splitSeparators = []string{"\n\n", "\n", " ", ""}
+
+func insideEntity(entities []MessageEntity, pos int) bool {
+	for _, e := range entities {
+		if e.Offset < pos && pos < e.Offset+e.Length {
+			return true
+		}
+	}
+	return false
+}
+
+func hasPrefixAt(u []uint16, pos int, sep []uint16) bool {
+	if pos+len(sep) > len(u) {
+		return false
+	}
+	for i, c := range sep {
+		if u[pos+i] != c {
+			return false
+		}
+	}
+	return true
+}
+
+func isHighSurrogate(c uint16) bool {
+	return c >= 0xD800 && c < 0xDC00
+}
+
+// findCut returns end of the first part and start of the next part.
+func findCut(u []uint16, entities []MessageEntity, limit int) (int, int) {
+	maxEnd := limit
+	minEnd := limit / 2
+	if minEnd < 1 {
+		minEnd = 1
+	}
+
+	// first try to keep entities unbroken, then any boundary
+	for _, keepEntities := range []bool{true, false} {
+		for _, sep := range splitSeparators {
+			sep16 := utf16.Encode([]rune(sep))
+
+			for end := maxEnd; end >= minEnd; end-- {
+				if !hasPrefixAt(u, end, sep16) {
+					continue
+				}
+				if isHighSurrogate(u[end-1]) {
+					// don't break surrogate pair
+					continue
+				}
+				if keepEntities && insideEntity(entities, end) {
+					continue
+				}
+
+				return end, end + len(sep16)
+			}
+		}
+	}
+
+	if isHighSurrogate(u[maxEnd-1]) {
+		// limit is too small for the surrogate pair, exceed it to make progress
+		maxEnd++
+	}
+	return maxEnd, maxEnd
+}
+
+// sliceEntities returns entities clipped to [start, end) and moved to the start.
+func sliceEntities(entities []MessageEntity, start, end int) []MessageEntity {
+	var res []MessageEntity
+	for _, e := range entities {
+		from, to := e.Offset, e.Offset+e.Length
+		if from < start {
+			from = start
+		}
+		if to > end {
+			to = end
+		}
+		if from >= to {
+			continue
+		}
+
+		e.Offset = from - start
+		e.Length = to - from
+		res = append(res, e)
+	}
+
+	return res
+}
+
+// cut returns the first part of at most limit UTF-16 code units and the rest of the text.
+func cut(text FormattedText, limit int) (FormattedText, FormattedText) {
+	u := utf16.Encode([]rune(text.Text))
+	if len(u) <= limit || limit <= 0 {
+		return text, FormattedText{}
+	}
+
+	end, next := findCut(u, text.Entities, limit)
+	head := FormattedText{
+		Text:     string(utf16.Decode(u[:end])),
+		Entities: sliceEntities(text.Entities, 0, end),
+	}
+	tail := FormattedText{
+		Text:     string(utf16.Decode(u[next:])),
+		Entities: sliceEntities(text.Entities, next, len(u)),
+	}
+
+	return head, tail
+}
+
+// Split splits text into parts of at most limit UTF-16 code units. Parts are
+// split at paragraph, line or word boundaries, which are removed, and cuts
+// inside entities are avoided when possible. Entities, which are still cut,
+// are continued in the next part. Parts of only whitespace are skipped.
+func Split(text FormattedText, limit int) []FormattedText {
+	var res []FormattedText
+	for {
+		head, tail := cut(text, limit)
+		if strings.TrimSpace(head.Text) != "" {
+			res = append(res, head)
+		}
+
+		if tail.Text == "" {
+			return res
+		}
+		text = tail
+	}
+}
@@ -0,0 +1,84 @@
+package telegram
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntityText(t *testing.T) {
+	m := &Message{
+		Text: "😀 hello @user",
+		Entities: []MessageEntity{
+			{Type: MessageEntityTypeBold, Offset: 3, Length: 5},
+			{Type: MessageEntityTypeMention, Offset: 9, Length: 5},
+		},
+	}
+
+	assert.Equal(t, "hello", m.EntityText(m.Entities[0]))
+	assert.Equal(t, "@user", m.EntityText(m.Entities[1]))
+	assert.Equal(t, "", EntityText("abc", MessageEntity{Offset: 5, Length: 1}))
+
+	m = &Message{Caption: "caption", CaptionEntities: []MessageEntity{{Type: MessageEntityTypeItalic, Length: 3}}}
+	assert.Equal(t, "cap", m.EntityText(m.CaptionEntities[0]))
+}
+
+func TestConcat(t *testing.T) {
+	res := Concat(
+		FormattedText{Text: "😀 ", Entities: []MessageEntity{{Type: MessageEntityTypeBold, Offset: 0, Length: 3}}},
+		FormattedText{Text: "bold", Entities: []MessageEntity{{Type: MessageEntityTypeBold, Offset: 0, Length: 4}}},
+		FormattedText{Text: " link", Entities: []MessageEntity{{Type: MessageEntityTypeTextLink, Offset: 1, Length: 4, URL: "a"}}},
+	)
+
+	assert.Equal(t, "😀 bold link", res.Text)
+	assert.Equal(t, []MessageEntity{
+		{Type: MessageEntityTypeBold, Offset: 0, Length: 7},
+		{Type: MessageEntityTypeTextLink, Offset: 8, Length: 4, URL: "a"},
+	}, res.Entities)
+}
+
+func TestMergeEntitiesMention(t *testing.T) {
+	res := MergeEntities([]MessageEntity{
+		{Type: MessageEntityTypeTextMention, Offset: 0, Length: 2, User: &User{ID: 1}},
+		{Type: MessageEntityTypeTextMention, Offset: 2, Length: 2, User: &User{ID: 1}},
+		{Type: MessageEntityTypeTextMention, Offset: 4, Length: 2, User: &User{ID: 2}},
+	})
+
+	assert.Equal(t, []MessageEntity{
+		{Type: MessageEntityTypeTextMention, Offset: 0, Length: 4, User: &User{ID: 1}},
+		{Type: MessageEntityTypeTextMention, Offset: 4, Length: 2, User: &User{ID: 2}},
+	}, res)
+}
+
+func TestSplit(t *testing.T) {
+	text := FormattedText{
+		Text: "first paragraph\n\nsecond line\nthird",
+		Entities: []MessageEntity{
+			{Type: MessageEntityTypeBold, Offset: 6, Length: 22},
+		},
+	}
+
+	parts := Split(text, 20)
+	assert.Equal(t, []FormattedText{
+		{Text: "first paragraph", Entities: []MessageEntity{{Type: MessageEntityTypeBold, Offset: 6, Length: 9}}},
+		{Text: "second line\nthird", Entities: []MessageEntity{{Type: MessageEntityTypeBold, Offset: 0, Length: 11}}},
+	}, parts)
+
+	// code is not broken, even though the word boundary is inside
+	text = FormattedText{
+		Text:     "aaaaaa bbbb cc dd",
+		Entities: []MessageEntity{{Type: MessageEntityTypeCode, Offset: 7, Length: 7}},
+	}
+	parts = Split(text, 12)
+	assert.Equal(t, "aaaaaa", parts[0].Text)
+	assert.Equal(t, "bbbb cc dd", parts[1].Text)
+
+	// surrogate pairs are not broken
+	parts = Split(FormattedText{Text: strings.Repeat("😀", 5)}, 3)
+	assert.Equal(t, []FormattedText{{Text: "😀"}, {Text: "😀"}, {Text: "😀"}, {Text: "😀"}, {Text: "😀"}}, parts)
+
+	for _, p := range Split(FormattedText{Text: strings.Repeat("word ", 2000)}, MaxMessageLength) {
+		assert.True(t, UTF16Len(p.Text) <= MaxMessageLength)
+	}
+}
@@ -0,0 +1,175 @@
+// Code generated by telegram-apigen. DO NOT EDIT.
+
+package telegram
+
+// ParseMode is a set of documented values for sendMessage.parse_mode, copyMessage.parse_mode, sendPhoto.parse_mode and others.
+type ParseMode string
+
+const (
+	ParseModeHTML       ParseMode = "HTML"
+	ParseModeMarkdownV2 ParseMode = "MarkdownV2"
+	ParseModeMarkdown   ParseMode = "Markdown"
+)
+
+// IsValid reports whether the value is one of the known ParseMode values.
+func (v ParseMode) IsValid() bool {
+	switch v {
+	case ParseModeHTML, ParseModeMarkdownV2, ParseModeMarkdown:
+		return true
+	}
+	return false
+}
+
+// ChatType is a set of documented values for Chat.type.
+type ChatType string
+
+const (
+	ChatTypePrivate    ChatType = "private"
+	ChatTypeGroup      ChatType = "group"
+	ChatTypeSupergroup ChatType = "supergroup"
+	ChatTypeChannel    ChatType = "channel"
+)
+
+// IsValid reports whether the value is one of the known ChatType values.
+func (v ChatType) IsValid() bool {
+	switch v {
+	case ChatTypePrivate, ChatTypeGroup, ChatTypeSupergroup, ChatTypeChannel:
+		return true
+	}
+	return false
+}
+
+// InlineQueryChatType is a set of documented values for InlineQuery.chat_type.
+type InlineQueryChatType string
+
+const (
+	InlineQueryChatTypeSender     InlineQueryChatType = "sender"
+	InlineQueryChatTypePrivate    InlineQueryChatType = "private"
+	InlineQueryChatTypeGroup      InlineQueryChatType = "group"
+	InlineQueryChatTypeSupergroup InlineQueryChatType = "supergroup"
+	InlineQueryChatTypeChannel    InlineQueryChatType = "channel"
+)
+
+// IsValid reports whether the value is one of the known InlineQueryChatType values.
+func (v InlineQueryChatType) IsValid() bool {
+	switch v {
+	case InlineQueryChatTypeSender, InlineQueryChatTypePrivate, InlineQueryChatTypeGroup, InlineQueryChatTypeSupergroup, InlineQueryChatTypeChannel:
+		return true
+	}
+	return false
+}
+
+// ChatAction is a set of documented values for sendChatAction.action.
+type ChatAction string
+
+const (
+	ChatActionTyping          ChatAction = "typing"
+	ChatActionUploadPhoto     ChatAction = "upload_photo"
+	ChatActionRecordVideo     ChatAction = "record_video"
+	ChatActionUploadVideo     ChatAction = "upload_video"
+	ChatActionRecordVoice     ChatAction = "record_voice"
+	ChatActionUploadVoice     ChatAction = "upload_voice"
+	ChatActionUploadDocument  ChatAction = "upload_document"
+	ChatActionFindLocation    ChatAction = "find_location"
+	ChatActionRecordVideoNote ChatAction = "record_video_note"
+	ChatActionUploadVideoNote ChatAction = "upload_video_note"
+)
+
+// IsValid reports whether the value is one of the known ChatAction values.
+func (v ChatAction) IsValid() bool {
+	switch v {
+	case ChatActionTyping, ChatActionUploadPhoto, ChatActionRecordVideo, ChatActionUploadVideo, ChatActionRecordVoice, ChatActionUploadVoice, ChatActionUploadDocument, ChatActionFindLocation, ChatActionRecordVideoNote, ChatActionUploadVideoNote:
+		return true
+	}
+	return false
+}
+
+// ChatMemberStatus is a set of documented values for ChatMember.status.
+type ChatMemberStatus string
+
+const (
+	ChatMemberStatusCreator       ChatMemberStatus = "creator"
+	ChatMemberStatusAdministrator ChatMemberStatus = "administrator"
+	ChatMemberStatusMember        ChatMemberStatus = "member"
+	ChatMemberStatusRestricted    ChatMemberStatus = "restricted"
+	ChatMemberStatusLeft          ChatMemberStatus = "left"
+	ChatMemberStatusKicked        ChatMemberStatus = "kicked"
+)
+
+// IsValid reports whether the value is one of the known ChatMemberStatus values.
+func (v ChatMemberStatus) IsValid() bool {
+	switch v {
+	case ChatMemberStatusCreator, ChatMemberStatusAdministrator, ChatMemberStatusMember, ChatMemberStatusRestricted, ChatMemberStatusLeft, ChatMemberStatusKicked:
+		return true
+	}
+	return false
+}
+
+// MessageEntityType is a set of documented values for MessageEntity.type.
+type MessageEntityType string
+
+const (
+	MessageEntityTypeSpoiler       MessageEntityType = "spoiler"
+	MessageEntityTypeMention       MessageEntityType = "mention"
+	MessageEntityTypeHashtag       MessageEntityType = "hashtag"
+	MessageEntityTypeCashtag       MessageEntityType = "cashtag"
+	MessageEntityTypeBotCommand    MessageEntityType = "bot_command"
+	MessageEntityTypeURL           MessageEntityType = "url"
+	MessageEntityTypeEmail         MessageEntityType = "email"
+	MessageEntityTypePhoneNumber   MessageEntityType = "phone_number"
+	MessageEntityTypeBold          MessageEntityType = "bold"
+	MessageEntityTypeItalic        MessageEntityType = "italic"
+	MessageEntityTypeUnderline     MessageEntityType = "underline"
+	MessageEntityTypeStrikethrough MessageEntityType = "strikethrough"
+	MessageEntityTypeCode          MessageEntityType = "code"
+	MessageEntityTypePre           MessageEntityType = "pre"
+	MessageEntityTypeTextLink      MessageEntityType = "text_link"
+	MessageEntityTypeTextMention   MessageEntityType = "text_mention"
+)
+
+// IsValid reports whether the value is one of the known MessageEntityType values.
+func (v MessageEntityType) IsValid() bool {
+	switch v {
+	case MessageEntityTypeSpoiler, MessageEntityTypeMention, MessageEntityTypeHashtag, MessageEntityTypeCashtag, MessageEntityTypeBotCommand, MessageEntityTypeURL, MessageEntityTypeEmail, MessageEntityTypePhoneNumber, MessageEntityTypeBold, MessageEntityTypeItalic, MessageEntityTypeUnderline, MessageEntityTypeStrikethrough, MessageEntityTypeCode, MessageEntityTypePre, MessageEntityTypeTextLink, MessageEntityTypeTextMention:
+		return true
+	}
+	return false
+}
+
+// PollType is a set of documented values for sendPoll.type, Poll.type.
+type PollType string
+
+const (
+	PollTypeRegular PollType = "regular"
+	PollTypeQuiz    PollType = "quiz"
+)
+
+// IsValid reports whether the value is one of the known PollType values.
+func (v PollType) IsValid() bool {
+	switch v {
+	case PollTypeRegular, PollTypeQuiz:
+		return true
+	}
+	return false
+}
+
+// DiceEmoji is a set of documented values for sendDice.emoji, Dice.emoji.
+type DiceEmoji string
+
+const (
+	DiceEmojiDice        DiceEmoji = "🎲"
+	DiceEmojiDarts       DiceEmoji = "🎯"
+	DiceEmojiBasketball  DiceEmoji = "🏀"
+	DiceEmojiFootball    DiceEmoji = "⚽"
+	DiceEmojiBowling     DiceEmoji = "🎳"
+	DiceEmojiSlotMachine DiceEmoji = "🎰"
+)
+
+// IsValid reports whether the value is one of the known DiceEmoji values.
+func (v DiceEmoji) IsValid() bool {
+	switch v {
+	case DiceEmojiDice, DiceEmojiDarts, DiceEmojiBasketball, DiceEmojiFootball, DiceEmojiBowling, DiceEmojiSlotMachine:
+		return true
+	}
+	return false
+}
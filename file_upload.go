@@ -68,6 +68,11 @@ func isFileUpload(req interface{}) (upload fileUpload, isUpload bool) {
 			continue
 		}
 
+		// optional scalars can be pointers, send them as plain values
+		if f.Kind() == reflect.Ptr && !f.IsNil() && f.Elem().Kind() != reflect.Struct {
+			f = f.Elem()
+		}
+
 		kind := f.Kind()
 		if kind == reflect.String {
 			upload.params[fieldName] = f.String()
@@ -0,0 +1,123 @@
+package format
+
+import (
+	"sort"
+	"unicode/utf16"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+// FromEntities converts text with entities to a node, which can be rendered
+// to HTML or MarkdownV2. Entities, which are detected by telegram
+// automatically (mentions, urls, etc.), become plain text.
+func FromEntities(text telegram.FormattedText) Node {
+	u := utf16.Encode([]rune(text.Text))
+
+	entities := append([]telegram.MessageEntity(nil), text.Entities...)
+	sortEntities(entities)
+
+	return Join(buildNodes(u, entities, 0, len(u))...)
+}
+
+// sortEntities sorts entities by offset, outer entities go first.
+func sortEntities(entities []telegram.MessageEntity) {
+	sort.SliceStable(entities, func(i, j int) bool {
+		if entities[i].Offset != entities[j].Offset {
+			return entities[i].Offset < entities[j].Offset
+		}
+		return entities[i].Length > entities[j].Length
+	})
+}
+
+// FromMessage converts message text or caption to a node.
+func FromMessage(m *telegram.Message) Node {
+	return FromEntities(m.FormattedText())
+}
+
+func textNode(u []uint16, start, end int) Node {
+	return Text(string(utf16.Decode(u[start:end])))
+}
+
+// buildNodes returns nodes for [start, end), entities must be sorted.
+func buildNodes(u []uint16, entities []telegram.MessageEntity, start, end int) []Node {
+	var res []Node
+	pos := start
+
+	for i := 0; i < len(entities); i++ {
+		e := entities[i]
+		from, to := e.Offset, e.Offset+e.Length
+		if from < pos {
+			// overlaps with the previous entity
+			from = pos
+		}
+		if to > end {
+			to = end
+		}
+		if from >= to {
+			continue
+		}
+
+		// entities inside the current one, the parts of partially
+		// overlapping entities after its end are formatted separately
+		var rest []telegram.MessageEntity
+		j := i + 1
+		for j < len(entities) && entities[j].Offset < to {
+			if tail := entities[j].Offset + entities[j].Length - to; tail > 0 {
+				split := entities[j]
+				split.Offset, split.Length = to, tail
+				rest = append(rest, split)
+			}
+			j++
+		}
+
+		if from > pos {
+			res = append(res, textNode(u, pos, from))
+		}
+		res = append(res, entityNode(e, u, entities[i+1:j], from, to))
+
+		pos = to
+		i = j - 1
+
+		if len(rest) != 0 {
+			rest = append(rest, entities[j:]...)
+			sortEntities(rest)
+			entities = rest
+			i = -1
+		}
+	}
+
+	if pos < end {
+		res = append(res, textNode(u, pos, end))
+	}
+
+	return res
+}
+
+func entityNode(e telegram.MessageEntity, u []uint16, inner []telegram.MessageEntity, start, end int) Node {
+	children := buildNodes(u, inner, start, end)
+
+	switch e.Type {
+	case telegram.MessageEntityTypeBold,
+		telegram.MessageEntityTypeItalic,
+		telegram.MessageEntityTypeUnderline,
+		telegram.MessageEntityTypeStrikethrough,
+		telegram.MessageEntityTypeSpoiler:
+		return wrap(e.Type, children)
+
+	case telegram.MessageEntityTypeCode:
+		return Code(textNode(u, start, end).text)
+
+	case telegram.MessageEntityTypePre:
+		return Pre(textNode(u, start, end).text, e.Language)
+
+	case telegram.MessageEntityTypeTextLink:
+		return Link(e.URL, children...)
+
+	case telegram.MessageEntityTypeTextMention:
+		if e.User != nil {
+			return Mention(e.User, children...)
+		}
+	}
+
+	return Join(children...)
+}
@@ -0,0 +1,54 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+func TestFromEntities(t *testing.T) {
+	m := &telegram.Message{
+		Text: "😀 bold italic @user <code>",
+		Entities: []telegram.MessageEntity{
+			{Type: telegram.MessageEntityTypeBold, Offset: 3, Length: 11},
+			{Type: telegram.MessageEntityTypeItalic, Offset: 8, Length: 6},
+			{Type: telegram.MessageEntityTypeMention, Offset: 15, Length: 5},
+			{Type: telegram.MessageEntityTypeCode, Offset: 21, Length: 6},
+		},
+	}
+
+	n := FromMessage(m)
+	assert.Equal(t, "😀 <b>bold <i>italic</i></b> @user <code>&lt;code&gt;</code>", n.HTML())
+	assert.Equal(t, "😀 *bold _italic_* @user `<code>`", n.MarkdownV2())
+
+	// round trip
+	text, entities := n.Entities()
+	assert.Equal(t, m.Text, text)
+	assert.Equal(t, []telegram.MessageEntity{m.Entities[0], m.Entities[1], m.Entities[3]}, entities)
+}
+
+func TestFromEntitiesOverlap(t *testing.T) {
+	n := FromEntities(telegram.FormattedText{
+		Text: "abcdefghij",
+		Entities: []telegram.MessageEntity{
+			{Type: telegram.MessageEntityTypeBold, Offset: 0, Length: 5},
+			{Type: telegram.MessageEntityTypeItalic, Offset: 3, Length: 5},
+		},
+	})
+
+	assert.Equal(t, "<b>abc<i>de</i></b><i>fgh</i>ij", n.HTML())
+
+	text, entities := n.Entities()
+	assert.Equal(t, "abcdefghij", text)
+	assert.Equal(t, []telegram.MessageEntity{
+		{Type: telegram.MessageEntityTypeBold, Offset: 0, Length: 5},
+		{Type: telegram.MessageEntityTypeItalic, Offset: 3, Length: 2},
+		{Type: telegram.MessageEntityTypeItalic, Offset: 5, Length: 3},
+	}, entities)
+	assert.Equal(t, []telegram.MessageEntity{
+		{Type: telegram.MessageEntityTypeBold, Offset: 0, Length: 5},
+		{Type: telegram.MessageEntityTypeItalic, Offset: 3, Length: 5},
+	}, telegram.MergeEntities(entities))
+}
@@ -0,0 +1,360 @@
+package format
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+// Node is a part of formatted text. Nodes are immutable and can be nested,
+// text inside is escaped only when rendering to the specific parse mode.
+type Node struct {
+	typ      telegram.MessageEntityType // empty for plain text
+	text     string
+	children []Node
+	url      string
+	user     *telegram.User
+	language string
+}
+
+// Text returns plain text node.
+func Text(s string) Node {
+	return Node{text: s}
+}
+
+// Textf returns plain text node, formatted with fmt.Sprintf.
+func Textf(format string, a ...interface{}) Node {
+	return Text(fmt.Sprintf(format, a...))
+}
+
+// Join concatenates nodes.
+func Join(nodes ...Node) Node {
+	return Node{children: nodes}
+}
+
+// Lines concatenates nodes, separating them with a newline.
+func Lines(nodes ...Node) Node {
+	var res []Node
+	for i, n := range nodes {
+		if i > 0 {
+			res = append(res, Text("\n"))
+		}
+		res = append(res, n)
+	}
+
+	return Join(res...)
+}
+
+func wrap(typ telegram.MessageEntityType, nodes []Node) Node {
+	return Node{typ: typ, children: nodes}
+}
+
+func Bold(nodes ...Node) Node {
+	return wrap(telegram.MessageEntityTypeBold, nodes)
+}
+
+func Italic(nodes ...Node) Node {
+	return wrap(telegram.MessageEntityTypeItalic, nodes)
+}
+
+func Underline(nodes ...Node) Node {
+	return wrap(telegram.MessageEntityTypeUnderline, nodes)
+}
+
+func Strikethrough(nodes ...Node) Node {
+	return wrap(telegram.MessageEntityTypeStrikethrough, nodes)
+}
+
+func Spoiler(nodes ...Node) Node {
+	return wrap(telegram.MessageEntityTypeSpoiler, nodes)
+}
+
+// Code returns monowidth string. Code can't contain other entities.
+func Code(s string) Node {
+	return Node{typ: telegram.MessageEntityTypeCode, text: s}
+}
+
+// Pre returns monowidth block, language can be empty.
+func Pre(s string, language string) Node {
+	return Node{typ: telegram.MessageEntityTypePre, text: s, language: language}
+}
+
+// Link returns clickable text url.
+func Link(url string, nodes ...Node) Node {
+	return Node{typ: telegram.MessageEntityTypeTextLink, children: nodes, url: url}
+}
+
+// Mention returns mention of the user, which works for users without usernames.
+func Mention(user *telegram.User, nodes ...Node) Node {
+	return Node{typ: telegram.MessageEntityTypeTextMention, children: nodes, user: user}
+}
+
+func userURL(user *telegram.User) string {
+	return "tg://user?id=" + strconv.Itoa(user.ID)
+}
+
+// String returns text without formatting.
+func (n Node) String() string {
+	var b strings.Builder
+	n.writePlain(&b)
+	return b.String()
+}
+
+func (n Node) writePlain(b *strings.Builder) {
+	b.WriteString(n.text)
+	for _, c := range n.children {
+		c.writePlain(b)
+	}
+}
+
+// Entities returns text without formatting and entities, describing it.
+// Can be used for SendMessageRequest.Entities without parse mode.
+func (n Node) Entities() (string, []telegram.MessageEntity) {
+	var b strings.Builder
+	var entities []telegram.MessageEntity
+	offset := 0
+
+	n.writeEntities(&b, &offset, &entities)
+	return b.String(), entities
+}
+
+func (n Node) writeEntities(b *strings.Builder, offset *int, entities *[]telegram.MessageEntity) {
+	start := *offset
+	idx := len(*entities)
+	if n.typ != "" {
+		// reserve place, to keep entities sorted by offset
+		*entities = append(*entities, telegram.MessageEntity{})
+	}
+
+	b.WriteString(n.text)
+	*offset += telegram.UTF16Len(n.text)
+	for _, c := range n.children {
+		c.writeEntities(b, offset, entities)
+	}
+
+	if n.typ == "" {
+		return
+	}
+
+	length := *offset - start
+	if length == 0 {
+		// telegram rejects empty entities
+		*entities = append((*entities)[:idx], (*entities)[idx+1:]...)
+		return
+	}
+
+	(*entities)[idx] = telegram.MessageEntity{
+		Type:     n.typ,
+		Offset:   start,
+		Length:   length,
+		URL:      n.url,
+		User:     n.user,
+		Language: n.language,
+	}
+}
+
+var htmlEscaper = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	`"`, "&quot;",
+)
+
+// EscapeHTML escapes text for HTML parse mode.
+func EscapeHTML(s string) string {
+	return htmlEscaper.Replace(s)
+}
+
+var htmlTags = map[telegram.MessageEntityType]string{
+	telegram.MessageEntityTypeBold:          "b",
+	telegram.MessageEntityTypeItalic:        "i",
+	telegram.MessageEntityTypeUnderline:     "u",
+	telegram.MessageEntityTypeStrikethrough: "s",
+	telegram.MessageEntityTypeSpoiler:       "tg-spoiler",
+}
+
+// HTML returns text for telegram.ParseModeHTML.
+func (n Node) HTML() string {
+	var b strings.Builder
+	n.writeHTML(&b)
+	return b.String()
+}
+
+func (n Node) writeHTML(b *strings.Builder) {
+	switch n.typ {
+	case telegram.MessageEntityTypeCode:
+		b.WriteString("<code>" + EscapeHTML(n.text) + "</code>")
+		return
+
+	case telegram.MessageEntityTypePre:
+		if n.language == "" {
+			b.WriteString("<pre>" + EscapeHTML(n.text) + "</pre>")
+		} else {
+			b.WriteString(`<pre><code class="language-` + EscapeHTML(n.language) + `">` + EscapeHTML(n.text) + "</code></pre>")
+		}
+		return
+
+	case telegram.MessageEntityTypeTextLink:
+		b.WriteString(`<a href="` + EscapeHTML(n.url) + `">`)
+		n.writeHTMLChildren(b)
+		b.WriteString("</a>")
+		return
+
+	case telegram.MessageEntityTypeTextMention:
+		b.WriteString(`<a href="` + userURL(n.user) + `">`)
+		n.writeHTMLChildren(b)
+		b.WriteString("</a>")
+		return
+	}
+
+	tag, ok := htmlTags[n.typ]
+	if ok {
+		b.WriteString("<" + tag + ">")
+	}
+
+	n.writeHTMLChildren(b)
+
+	if ok {
+		b.WriteString("</" + tag + ">")
+	}
+}
+
+func (n Node) writeHTMLChildren(b *strings.Builder) {
+	b.WriteString(EscapeHTML(n.text))
+	for _, c := range n.children {
+		c.writeHTML(b)
+	}
+}
+
+var (
+	markdownV2Escaper = escaper("_*[]()~`>#+-=|{}.!\\")
+	codeEscaper       = escaper("`\\")
+	linkEscaper       = escaper(")\\")
+)
+
+func escaper(chars string) *strings.Replacer {
+	var pairs []string
+	for _, c := range chars {
+		pairs = append(pairs, string(c), "\\"+string(c))
+	}
+
+	return strings.NewReplacer(pairs...)
+}
+
+// EscapeMarkdownV2 escapes text for MarkdownV2 parse mode.
+func EscapeMarkdownV2(s string) string {
+	return markdownV2Escaper.Replace(s)
+}
+
+var markdownV2Markers = map[telegram.MessageEntityType]string{
+	telegram.MessageEntityTypeBold:          "*",
+	telegram.MessageEntityTypeItalic:        "_",
+	telegram.MessageEntityTypeUnderline:     "__",
+	telegram.MessageEntityTypeStrikethrough: "~",
+	telegram.MessageEntityTypeSpoiler:       "||",
+}
+
+type markdownWriter struct {
+	strings.Builder
+	afterUnderscore bool // last written is "_" or "__" marker
+}
+
+func (w *markdownWriter) text(s string) {
+	if s == "" {
+		return
+	}
+
+	w.WriteString(s)
+	w.afterUnderscore = false
+}
+
+func (w *markdownWriter) marker(s string) {
+	if w.afterUnderscore && strings.HasPrefix(s, "_") {
+		// italic and underline markers are ambiguous without a separator
+		w.WriteString("\r")
+	}
+
+	w.WriteString(s)
+	w.afterUnderscore = strings.HasPrefix(s, "_")
+}
+
+// MarkdownV2 returns text for telegram.ParseModeMarkdownV2.
+func (n Node) MarkdownV2() string {
+	var w markdownWriter
+	n.writeMarkdownV2(&w)
+	return w.String()
+}
+
+func (n Node) writeMarkdownV2(w *markdownWriter) {
+	switch n.typ {
+	case telegram.MessageEntityTypeCode:
+		w.text("`" + codeEscaper.Replace(n.text) + "`")
+		return
+
+	case telegram.MessageEntityTypePre:
+		w.text("```" + n.language + "\n" + codeEscaper.Replace(n.text) + "\n```")
+		return
+
+	case telegram.MessageEntityTypeTextLink, telegram.MessageEntityTypeTextMention:
+		url := n.url
+		if n.typ == telegram.MessageEntityTypeTextMention {
+			url = userURL(n.user)
+		}
+
+		w.marker("[")
+		n.writeMarkdownV2Children(w)
+		w.marker("](" + linkEscaper.Replace(url) + ")")
+		return
+	}
+
+	marker, ok := markdownV2Markers[n.typ]
+	if ok {
+		w.marker(marker)
+	}
+
+	n.writeMarkdownV2Children(w)
+
+	if ok {
+		w.marker(marker)
+	}
+}
+
+func (n Node) writeMarkdownV2Children(w *markdownWriter) {
+	w.text(EscapeMarkdownV2(n.text))
+	for _, c := range n.children {
+		c.writeMarkdownV2(w)
+	}
+}
+
+// NewMessage returns request with the text and entities, which doesn't
+// depend on escaping of the parse mode.
+func NewMessage(chatID string, n Node) *telegram.SendMessageRequest {
+	text, entities := n.Entities()
+	return telegram.NewMessage(chatID, text).WithEntities(entities)
+}
+
+// Rendered is the text with markup and the parse mode of the markup, they
+// must be sent together.
+type Rendered struct {
+	Text      string
+	ParseMode telegram.ParseMode
+}
+
+// Render renders the node in HTML or MarkdownV2 parse mode. Legacy Markdown
+// is not supported, other modes return plain text with empty parse mode.
+func (n Node) Render(mode telegram.ParseMode) Rendered {
+	switch mode {
+	case telegram.ParseModeHTML:
+		return Rendered{Text: n.HTML(), ParseMode: mode}
+	case telegram.ParseModeMarkdownV2:
+		return Rendered{Text: n.MarkdownV2(), ParseMode: mode}
+	}
+	return Rendered{Text: n.String()}
+}
+
+// Message returns request with the text and its parse mode.
+func (r Rendered) Message(chatID string) *telegram.SendMessageRequest {
+	return telegram.NewMessage(chatID, r.Text).WithParseMode(r.ParseMode)
+}
@@ -0,0 +1,95 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+func TestHTML(t *testing.T) {
+	n := Join(
+		Text("1 < 2 & "),
+		Bold(Text("bold "), Italic(Text("italic"))),
+		Text(" "),
+		Link("https://example.com/?a=1&b=\"2\"", Text("link")),
+		Text(" "),
+		Code("<code>"),
+		Spoiler(Text("secret")),
+		Pre("fmt.Println()", "go"),
+	)
+
+	assert.Equal(t,
+		`1 &lt; 2 &amp; <b>bold <i>italic</i></b> <a href="https://example.com/?a=1&amp;b=&quot;2&quot;">link</a> <code>&lt;code&gt;</code><tg-spoiler>secret</tg-spoiler><pre><code class="language-go">fmt.Println()</code></pre>`,
+		n.HTML(),
+	)
+}
+
+func TestMarkdownV2(t *testing.T) {
+	n := Lines(
+		Join(Text("Price: 1.5$ (-10%) "), Bold(Text("a_b*c"))),
+		Link("https://example.com/a_(b)", Text("[link]")),
+		Code("a`b\\c_d"),
+		Mention(&telegram.User{ID: 42}, Text("user")),
+		Spoiler(Strikethrough(Text("x"))),
+	)
+
+	assert.Equal(t,
+		"Price: 1\\.5$ \\(\\-10%\\) *a\\_b\\*c*\n"+
+			"[\\[link\\]](https://example.com/a_(b\\))\n"+
+			"`a\\`b\\\\c_d`\n"+
+			"[user](tg://user?id=42)\n"+
+			"||~x~||",
+		n.MarkdownV2(),
+	)
+}
+
+func TestMarkdownV2ItalicUnderline(t *testing.T) {
+	n := Italic(Underline(Text("text")))
+	assert.Equal(t, "_\r__text__\r_", n.MarkdownV2())
+}
+
+func TestEntities(t *testing.T) {
+	n := Join(
+		Text("😀 "),
+		Bold(Text("bold "), Italic(Text("both"))),
+		Italic(),
+		Text(" "),
+		Pre("code", "go"),
+		Text(" "),
+		Spoiler(Text("s")),
+	)
+
+	text, entities := n.Entities()
+	assert.Equal(t, "😀 bold both code s", text)
+	assert.Equal(t, []telegram.MessageEntity{
+		{Type: telegram.MessageEntityTypeBold, Offset: 3, Length: 9},
+		{Type: telegram.MessageEntityTypeItalic, Offset: 8, Length: 4},
+		{Type: telegram.MessageEntityTypePre, Offset: 13, Length: 4, Language: "go"},
+		{Type: telegram.MessageEntityTypeSpoiler, Offset: 18, Length: 1},
+	}, entities)
+
+	assert.Equal(t, text, n.String())
+
+	req := NewMessage("1", n)
+	assert.Equal(t, text, req.Text)
+	assert.Equal(t, entities, req.Entities)
+}
+
+func TestRender(t *testing.T) {
+	n := Join(Text("a < b "), Bold(Text("c.")))
+
+	r := n.Render(telegram.ParseModeHTML)
+	assert.Equal(t, Rendered{Text: "a &lt; b <b>c.</b>", ParseMode: telegram.ParseModeHTML}, r)
+
+	r = n.Render(telegram.ParseModeMarkdownV2)
+	assert.Equal(t, Rendered{Text: "a < b *c\\.*", ParseMode: telegram.ParseModeMarkdownV2}, r)
+
+	r = n.Render("")
+	assert.Equal(t, Rendered{Text: "a < b c."}, r)
+
+	req := n.Render(telegram.ParseModeHTML).Message("1")
+	assert.Equal(t, "a &lt; b <b>c.</b>", req.Text)
+	assert.Equal(t, telegram.ParseModeHTML, req.ParseMode)
+}
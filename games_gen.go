@@ -132,12 +132,10 @@ type GetGameHighScoresRequest struct {
 // specified user and several of their neighbors in a game. On success, returns an
 // Array of GameHighScore objects.
 //
-//
 // This method will currently return scores for the target user, plus two of their
 // closest neighbors on each side. Will also return the top three users if the user
 // and his neighbors are not among them. Please note that this behavior is subject
 // to change.
-//
 func (b *Bot) GetGameHighScores(req *GetGameHighScoresRequest) (*GameHighScore, error) {
 	j, err := b.makeRequest("getGameHighScores", req)
 	if err != nil {
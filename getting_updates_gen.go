@@ -98,12 +98,10 @@ type GetUpdatesRequest struct {
 // Use this method to receive incoming updates using long polling (wiki). An Array
 // of Update objects is returned.
 //
-//
 // Notes
 // 1. This method will not work if an outgoing webhook is set up.
 // 2. In order to avoid getting duplicate updates, recalculate offset after each
 // server response.
-//
 func (b *Bot) GetUpdates(req *GetUpdatesRequest) (*[]Update, error) {
 	j, err := b.makeRequest("getUpdates", req)
 	if err != nil {
@@ -158,7 +156,6 @@ type SetWebhookRequest struct {
 // recommend using a secret path in the URL, e.g. https://www.example.com/<token>.
 // Since nobody else knows your bot's token, you can be pretty sure it's us.
 //
-//
 // Notes
 // 1. You will not be able to receive updates using getUpdates for as long as an
 // outgoing webhook is set up.
@@ -168,7 +165,6 @@ type SetWebhookRequest struct {
 // 3. Ports currently supported for Webhooks: 443, 80, 88, 8443.
 // NEW! If you're having any trouble setting up webhooks, please check out this
 // amazing guide to Webhooks.
-//
 func (b *Bot) SetWebhook(req *SetWebhookRequest) (json.RawMessage, error) {
 	return b.makeRequest("setWebhook", req)
 }
@@ -8,5 +8,6 @@ require (
 	github.com/sirupsen/logrus v1.4.2
 	github.com/stretchr/testify v1.4.0
 	github.com/technoweenie/multipartstreamer v1.0.1
+	go.etcd.io/bbolt v1.3.6
 	golang.org/x/net v0.0.0-20200202094626-16171245cfb2
 )
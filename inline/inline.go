@@ -0,0 +1,256 @@
+package inline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+// MaxResults is the max count of results in one answer.
+const MaxResults = 50
+
+var (
+	ErrInvalidResult = errors.New("inline result must be a struct with string ID field")
+	ErrInvalidOffset = errors.New("invalid inline query offset")
+)
+
+// Item is a result of the source, Key identifies it among all results.
+type Item struct {
+	Key string
+
+	// Result is one of the InlineQueryResult types, its ID is set by the
+	// handler from the Key.
+	Result telegram.InlineQueryResult
+}
+
+// Source returns at most limit items of the query, starting from the
+// offset. Less than limit items means there are no more results.
+type Source func(q *telegram.InlineQuery, offset, limit int) ([]Item, error)
+
+// ResultID returns stable result ID of the item key, it's sent back in
+// ChosenInlineResult.ResultID.
+func ResultID(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:16])
+}
+
+// setID returns copy of the result with the ID field set.
+func setID(result telegram.InlineQueryResult, id string) (telegram.InlineQueryResult, error) {
+	v := reflect.ValueOf(result)
+	isPtr := v.Kind() == reflect.Ptr
+	if isPtr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, ErrInvalidResult
+	}
+
+	res := reflect.New(v.Type())
+	res.Elem().Set(v)
+
+	field := res.Elem().FieldByName("ID")
+	if !field.IsValid() || field.Kind() != reflect.String {
+		return nil, ErrInvalidResult
+	}
+	field.SetString(id)
+
+	if isPtr {
+		return res.Interface(), nil
+	}
+	return res.Elem().Interface(), nil
+}
+
+type answer struct {
+	results    []telegram.InlineQueryResult
+	nextOffset string
+	expires    time.Time
+}
+
+// Handler answers inline queries with the results of the source, page by page.
+type Handler struct {
+	bot    *telegram.Bot
+	source Source
+	now    func() time.Time
+
+	// PageSize is the count of results in one answer, at most MaxResults.
+	PageSize int
+
+	// CacheTime and IsPersonal are passed to AnswerInlineQuery, nil CacheTime
+	// means the default of Telegram.
+	CacheTime  *int
+	IsPersonal bool
+
+	// Debounce is the delay before the answer to the first page. The query is
+	// not answered, if the user has typed another one during the delay.
+	Debounce time.Duration
+
+	// CacheTTL enables local cache of the answers, zero disables it.
+	CacheTTL time.Duration
+
+	// OnChosen handles ChosenInlineResult updates, optional. Compare the
+	// ResultID with ResultID of the item key.
+	OnChosen func(r *telegram.ChosenInlineResult)
+
+	mu      sync.Mutex
+	pending map[int]int // user id -> sequence number of the last query
+	seq     int
+	cache   map[string]*answer
+}
+
+func NewHandler(bot *telegram.Bot, source Source) *Handler {
+	return &Handler{
+		bot:      bot,
+		source:   source,
+		now:      time.Now,
+		PageSize: MaxResults,
+		pending:  make(map[int]int),
+		cache:    make(map[string]*answer),
+	}
+}
+
+// HandleUpdate handles inline queries and chosen inline results, other
+// updates are ignored. Can be used with updates.Router.HandleKind.
+func (h *Handler) HandleUpdate(update *telegram.Update) {
+	switch {
+	case update.InlineQuery != nil:
+		h.HandleQuery(update.InlineQuery)
+	case update.ChosenInlineResult != nil && h.OnChosen != nil:
+		h.OnChosen(update.ChosenInlineResult)
+	}
+}
+
+// HandleQuery answers the query, possibly after the debounce delay.
+func (h *Handler) HandleQuery(q *telegram.InlineQuery) {
+	if h.Debounce == 0 || q.Offset != "" || q.From == nil {
+		h.answerLogged(q)
+		return
+	}
+
+	h.mu.Lock()
+	h.seq++
+	seq := h.seq
+	h.pending[q.From.ID] = seq
+	h.mu.Unlock()
+
+	time.AfterFunc(h.Debounce, func() {
+		h.mu.Lock()
+		last := h.pending[q.From.ID] == seq
+		if last {
+			delete(h.pending, q.From.ID)
+		}
+		h.mu.Unlock()
+
+		if last {
+			h.answerLogged(q)
+		}
+	})
+}
+
+func (h *Handler) answerLogged(q *telegram.InlineQuery) {
+	err := h.Answer(q)
+	if err != nil {
+		log.WithError(err).WithField("query", q.Query).Error("failed to answer inline query")
+	}
+}
+
+func (h *Handler) pageSize() int {
+	if h.PageSize <= 0 || h.PageSize > MaxResults {
+		return MaxResults
+	}
+	return h.PageSize
+}
+
+func (h *Handler) cacheKey(q *telegram.InlineQuery) string {
+	key := q.Offset + "\n" + q.Query
+	if h.IsPersonal && q.From != nil {
+		key = strconv.Itoa(q.From.ID) + "\n" + key
+	}
+	return key
+}
+
+// load returns the page of results from the cache or the source.
+func (h *Handler) load(q *telegram.InlineQuery) (*answer, error) {
+	key := h.cacheKey(q)
+	now := h.now()
+
+	if h.CacheTTL != 0 {
+		h.mu.Lock()
+		a, ok := h.cache[key]
+		h.mu.Unlock()
+
+		if ok && now.Before(a.expires) {
+			return a, nil
+		}
+	}
+
+	offset := 0
+	if q.Offset != "" {
+		var err error
+		offset, err = strconv.Atoi(q.Offset)
+		if err != nil || offset < 0 {
+			return nil, ErrInvalidOffset
+		}
+	}
+
+	limit := h.pageSize()
+	items, err := h.source(q, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	a := &answer{
+		results: []telegram.InlineQueryResult{},
+		expires: now.Add(h.CacheTTL),
+	}
+	for _, item := range items {
+		res, err := setID(item.Result, ResultID(item.Key))
+		if err != nil {
+			return nil, err
+		}
+		a.results = append(a.results, res)
+	}
+	if len(items) == limit {
+		a.nextOffset = strconv.Itoa(offset + limit)
+	}
+
+	if h.CacheTTL != 0 {
+		h.mu.Lock()
+		for k, v := range h.cache {
+			if !now.Before(v.expires) {
+				delete(h.cache, k)
+			}
+		}
+		h.cache[key] = a
+		h.mu.Unlock()
+	}
+
+	return a, nil
+}
+
+// Answer answers the query immediately.
+func (h *Handler) Answer(q *telegram.InlineQuery) error {
+	a, err := h.load(q)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.bot.AnswerInlineQuery(&telegram.AnswerInlineQueryRequest{
+		InlineQueryID: q.ID,
+		Results:       a.results,
+		CacheTime:     h.CacheTime,
+		IsPersonal:    h.IsPersonal,
+		NextOffset:    a.nextOffset,
+	})
+	return err
+}
@@ -0,0 +1,101 @@
+package inline
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/internal/telegramtest"
+)
+
+// answers returns the recorded answers to inline queries.
+func answers(rec *telegramtest.Recorder) []*telegram.AnswerInlineQueryRequest {
+	var res []*telegram.AnswerInlineQueryRequest
+	for _, r := range rec.Requests() {
+		res = append(res, r.Req.(*telegram.AnswerInlineQueryRequest))
+	}
+	return res
+}
+
+// numbers returns 7 articles with the query as a title.
+func numbers(calls *int) Source {
+	return func(q *telegram.InlineQuery, offset, limit int) ([]Item, error) {
+		*calls++
+
+		var items []Item
+		for i := offset; i < 7 && len(items) < limit; i++ {
+			items = append(items, Item{
+				Key:    strconv.Itoa(i),
+				Result: telegram.InlineQueryResultArticle{Type: "article", Title: q.Query},
+			})
+		}
+		return items, nil
+	}
+}
+
+func TestHandlerPagination(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	var calls int
+	h := NewHandler(rec.Bot(), numbers(&calls))
+	h.PageSize = 5
+	h.CacheTTL = time.Minute
+
+	assert.Nil(t, h.Answer(&telegram.InlineQuery{ID: "1", Query: "a"}))
+	assert.Nil(t, h.Answer(&telegram.InlineQuery{ID: "2", Query: "a", Offset: "5"}))
+	assert.Nil(t, h.Answer(&telegram.InlineQuery{ID: "3", Query: "a"}))
+	assert.Equal(t, ErrInvalidOffset, h.Answer(&telegram.InlineQuery{ID: "4", Query: "a", Offset: "x"}))
+
+	reqs := answers(rec)
+	assert.Len(t, reqs, 3)
+	assert.Equal(t, 2, calls)
+
+	assert.Len(t, reqs[0].Results, 5)
+	assert.Equal(t, "5", reqs[0].NextOffset)
+	assert.Equal(t, ResultID("0"), reqs[0].Results[0].(telegram.InlineQueryResultArticle).ID)
+
+	assert.Len(t, reqs[1].Results, 2)
+	assert.Equal(t, "", reqs[1].NextOffset)
+	assert.Equal(t, ResultID("6"), reqs[1].Results[1].(telegram.InlineQueryResultArticle).ID)
+
+	assert.Equal(t, reqs[0].Results, reqs[2].Results)
+
+	h.now = func() time.Time { return time.Now().Add(time.Hour) }
+	assert.Nil(t, h.Answer(&telegram.InlineQuery{ID: "5", Query: "a"}))
+	assert.Equal(t, 3, calls)
+}
+
+func TestHandlerDebounce(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	var calls int
+	h := NewHandler(rec.Bot(), numbers(&calls))
+	h.Debounce = 20 * time.Millisecond
+
+	user := &telegram.User{ID: 1}
+	h.HandleUpdate(&telegram.Update{InlineQuery: &telegram.InlineQuery{ID: "1", From: user, Query: "a"}})
+	h.HandleUpdate(&telegram.Update{InlineQuery: &telegram.InlineQuery{ID: "2", From: user, Query: "ab"}})
+
+	time.Sleep(100 * time.Millisecond)
+
+	reqs := answers(rec)
+	assert.Len(t, reqs, 1)
+	assert.Equal(t, "2", reqs[0].InlineQueryID)
+
+	var chosen string
+	h.OnChosen = func(r *telegram.ChosenInlineResult) {
+		chosen = r.ResultID
+	}
+	h.HandleUpdate(&telegram.Update{ChosenInlineResult: &telegram.ChosenInlineResult{ResultID: ResultID("3")}})
+	assert.Equal(t, ResultID("3"), chosen)
+}
+
+func TestSetID(t *testing.T) {
+	res, err := setID(&telegram.InlineQueryResultPhoto{Type: "photo"}, "id")
+	assert.Nil(t, err)
+	assert.Equal(t, "id", res.(*telegram.InlineQueryResultPhoto).ID)
+
+	_, err = setID("text", "id")
+	assert.Equal(t, ErrInvalidResult, err)
+}
@@ -24,7 +24,7 @@ type InlineQuery struct {
 	// “group”, “supergroup”, or “channel”. The chat type should be always
 	// known for requests sent from official clients and most third-party clients,
 	// unless the request was sent from a secret chat
-	ChatType string `json:"chat_type,omitempty"`
+	ChatType InlineQueryChatType `json:"chat_type,omitempty"`
 
 	// Optional. Sender location, only for bots that request user location
 	Location *Location `json:"location,omitempty"`
@@ -39,7 +39,7 @@ type AnswerInlineQueryRequest struct {
 
 	// Optional. The maximum amount of time in seconds that the result of the inline
 	// query may be cached on the server. Defaults to 300.
-	CacheTime int `json:"cache_time,omitempty"`
+	CacheTime *int `json:"cache_time,omitempty"`
 
 	// Optional. Pass True, if results may be cached on the server side only for the
 	// user that sent the query. By default, results may be returned to any user who
@@ -150,7 +150,7 @@ type InlineQueryResultPhoto struct {
 
 	// Optional. Mode for parsing entities in the photo caption. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -202,7 +202,7 @@ type InlineQueryResultGif struct {
 
 	// Optional. Mode for parsing entities in the caption. See formatting options for
 	// more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -254,7 +254,7 @@ type InlineQueryResultMpeg4Gif struct {
 
 	// Optional. Mode for parsing entities in the caption. See formatting options for
 	// more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -272,10 +272,8 @@ type InlineQueryResultMpeg4Gif struct {
 // Alternatively, you can use input_message_content to send a message with the
 // specified content instead of the video.
 //
-//
 // If an InlineQueryResultVideo message contains an embedded video (e.g., YouTube),
 // you must replace its content using input_message_content.
-//
 type InlineQueryResultVideo struct {
 	// Type of the result, must be video
 	Type string `json:"type"`
@@ -301,7 +299,7 @@ type InlineQueryResultVideo struct {
 
 	// Optional. Mode for parsing entities in the video caption. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -352,7 +350,7 @@ type InlineQueryResultAudio struct {
 
 	// Optional. Mode for parsing entities in the audio caption. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -396,7 +394,7 @@ type InlineQueryResultVoice struct {
 
 	// Optional. Mode for parsing entities in the voice message caption. See formatting
 	// options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -435,7 +433,7 @@ type InlineQueryResultDocument struct {
 
 	// Optional. Mode for parsing entities in the document caption. See formatting
 	// options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -664,7 +662,7 @@ type InlineQueryResultCachedPhoto struct {
 
 	// Optional. Mode for parsing entities in the photo caption. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -700,7 +698,7 @@ type InlineQueryResultCachedGif struct {
 
 	// Optional. Mode for parsing entities in the caption. See formatting options for
 	// more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -737,7 +735,7 @@ type InlineQueryResultCachedMpeg4Gif struct {
 
 	// Optional. Mode for parsing entities in the caption. See formatting options for
 	// more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -804,7 +802,7 @@ type InlineQueryResultCachedDocument struct {
 
 	// Optional. Mode for parsing entities in the document caption. See formatting
 	// options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -843,7 +841,7 @@ type InlineQueryResultCachedVideo struct {
 
 	// Optional. Mode for parsing entities in the video caption. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -881,7 +879,7 @@ type InlineQueryResultCachedVoice struct {
 
 	// Optional. Mode for parsing entities in the voice message caption. See formatting
 	// options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -916,7 +914,7 @@ type InlineQueryResultCachedAudio struct {
 
 	// Optional. Mode for parsing entities in the audio caption. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
@@ -937,7 +935,7 @@ type InputTextMessageContent struct {
 
 	// Optional. Mode for parsing entities in the message text. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in message text, which can be
 	// specified instead of parse_mode
@@ -0,0 +1,87 @@
+// Package telegramtest provides a fake bot for tests, which records requests
+// instead of sending them to the Bot API.
+package telegramtest
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+// Request is the recorded request of the bot.
+type Request struct {
+	Method string
+	Req    interface{}
+}
+
+// Handler returns the response to the request.
+type Handler func(method string, req interface{}) (json.RawMessage, error)
+
+// DefaultResponse returns a message for the methods, which send or edit
+// messages, and true for the other methods.
+func DefaultResponse(method string, req interface{}) (json.RawMessage, error) {
+	for _, prefix := range []string{"send", "edit", "forward", "copy"} {
+		if strings.HasPrefix(method, prefix) {
+			return json.RawMessage(`{"message_id":1}`), nil
+		}
+	}
+	return json.RawMessage(`true`), nil
+}
+
+// Recorder records requests of the bot. It's safe for concurrent use.
+type Recorder struct {
+	// Handler returns the responses, DefaultResponse is used when nil.
+	Handler Handler
+
+	mu       sync.Mutex
+	requests []Request
+}
+
+// Bot returns bot, which sends requests to the recorder.
+func (r *Recorder) Bot() *telegram.Bot {
+	return telegram.NewBotWithOpts("", &telegram.Opts{
+		Middleware: func(next telegram.RequestHandler) telegram.RequestHandler {
+			return r.handle
+		},
+	})
+}
+
+func (r *Recorder) handle(method string, req interface{}) (json.RawMessage, error) {
+	r.mu.Lock()
+	r.requests = append(r.requests, Request{Method: method, Req: req})
+	r.mu.Unlock()
+
+	if r.Handler != nil {
+		return r.Handler(method, req)
+	}
+	return DefaultResponse(method, req)
+}
+
+// Requests returns copy of the recorded requests.
+func (r *Recorder) Requests() []Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Request(nil), r.requests...)
+}
+
+// Last returns the last recorded request, zero Request if there are none.
+func (r *Recorder) Last() Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.requests) == 0 {
+		return Request{}
+	}
+	return r.requests[len(r.requests)-1]
+}
+
+// Reset removes the recorded requests.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests = nil
+}
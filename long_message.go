@@ -0,0 +1,165 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrLongMarkup is returned when the long text uses legacy Markdown parse
+// mode. Such text can't be split safely, use MarkdownV2, HTML or entities.
+var ErrLongMarkup = errors.New("text with legacy markdown is too long to be split")
+
+// parseLong converts markup of the long text to entities, which can be split.
+func parseLong(text string, entities []MessageEntity, mode ParseMode) (FormattedText, error) {
+	if mode == "" {
+		return FormattedText{Text: text, Entities: entities}, nil
+	}
+	if mode == ParseModeMarkdown {
+		return FormattedText{}, ErrLongMarkup
+	}
+	return ParseMarkup(text, mode)
+}
+
+// SendLongMessage sends text, which can be longer than MaxMessageLength, as
+// several messages. Text is split at paragraph, line or word boundaries,
+// reply is set for the first message and reply markup for the last one.
+// HTML and MarkdownV2 markup is converted to entities before splitting.
+// Messages sent before the error are returned with it.
+func (b *Bot) SendLongMessage(req *SendMessageRequest) ([]*Message, error) {
+	if UTF16Len(req.Text) <= MaxMessageLength {
+		msg, err := b.SendMessage(req)
+		if err != nil {
+			return nil, err
+		}
+		return []*Message{msg}, nil
+	}
+
+	text, err := parseLong(req.Text, req.Entities, req.ParseMode)
+	if err != nil {
+		return nil, err
+	}
+
+	plain := *req
+	plain.ParseMode = ""
+	return b.sendParts(&plain, Split(text, MaxMessageLength))
+}
+
+func (b *Bot) sendParts(req *SendMessageRequest, parts []FormattedText) ([]*Message, error) {
+	var res []*Message
+	for i, part := range parts {
+		partReq := *req
+		partReq.Text = part.Text
+		partReq.Entities = part.Entities
+
+		if i > 0 {
+			partReq.ReplyToMessageID = 0
+		}
+		if i+1 < len(parts) {
+			partReq.ReplyMarkup = nil
+		}
+
+		msg, err := b.SendMessage(&partReq)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, msg)
+	}
+
+	return res, nil
+}
+
+// mediaCaption gives access to the caption of the copy of media request.
+type mediaCaption struct {
+	chatID              string
+	caption             *string
+	entities            *[]MessageEntity
+	parseMode           *ParseMode
+	disableNotification bool
+	replyMarkup         *AnyKeyboard
+	send                func(b *Bot) (*Message, error)
+}
+
+func getMediaCaption(req interface{}) (*mediaCaption, error) {
+	switch r := req.(type) {
+	case *SendPhotoRequest:
+		c := *r
+		return &mediaCaption{c.ChatID, &c.Caption, &c.CaptionEntities, &c.ParseMode, c.DisableNotification, &c.ReplyMarkup,
+			func(b *Bot) (*Message, error) { return b.SendPhoto(&c) }}, nil
+	case *SendAudioRequest:
+		c := *r
+		return &mediaCaption{c.ChatID, &c.Caption, &c.CaptionEntities, &c.ParseMode, c.DisableNotification, &c.ReplyMarkup,
+			func(b *Bot) (*Message, error) { return b.SendAudio(&c) }}, nil
+	case *SendDocumentRequest:
+		c := *r
+		return &mediaCaption{c.ChatID, &c.Caption, &c.CaptionEntities, &c.ParseMode, c.DisableNotification, &c.ReplyMarkup,
+			func(b *Bot) (*Message, error) { return b.SendDocument(&c) }}, nil
+	case *SendVideoRequest:
+		c := *r
+		return &mediaCaption{c.ChatID, &c.Caption, &c.CaptionEntities, &c.ParseMode, c.DisableNotification, &c.ReplyMarkup,
+			func(b *Bot) (*Message, error) { return b.SendVideo(&c) }}, nil
+	case *SendAnimationRequest:
+		c := *r
+		return &mediaCaption{c.ChatID, &c.Caption, &c.CaptionEntities, &c.ParseMode, c.DisableNotification, &c.ReplyMarkup,
+			func(b *Bot) (*Message, error) { return b.SendAnimation(&c) }}, nil
+	case *SendVoiceRequest:
+		c := *r
+		return &mediaCaption{c.ChatID, &c.Caption, &c.CaptionEntities, &c.ParseMode, c.DisableNotification, &c.ReplyMarkup,
+			func(b *Bot) (*Message, error) { return b.SendVoice(&c) }}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported media request %T", req)
+}
+
+// SendMediaLongCaption sends media request (*SendPhotoRequest, *SendVideoRequest, etc.)
+// with caption, which can be longer than MaxCaptionLength. Overflowing part
+// of the caption is sent in the follow-up messages, reply markup is attached
+// to the last sent message. HTML and MarkdownV2 markup is converted to
+// entities before splitting. The request is not modified.
+func (b *Bot) SendMediaLongCaption(req interface{}) ([]*Message, error) {
+	media, err := getMediaCaption(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if UTF16Len(*media.caption) <= MaxCaptionLength {
+		msg, err := media.send(b)
+		if err != nil {
+			return nil, err
+		}
+		return []*Message{msg}, nil
+	}
+
+	caption, err := parseLong(*media.caption, *media.entities, *media.parseMode)
+	if err != nil {
+		return nil, err
+	}
+
+	head, tail := cut(caption, MaxCaptionLength)
+	parts := Split(tail, MaxMessageLength)
+	replyMarkup := *media.replyMarkup
+
+	*media.caption = head.Text
+	*media.entities = head.Entities
+	*media.parseMode = ""
+	if len(parts) != 0 {
+		// reply markup goes to the last message
+		*media.replyMarkup = nil
+	}
+
+	msg, err := media.send(b)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return []*Message{msg}, nil
+	}
+
+	followUp := &SendMessageRequest{
+		ChatID:              media.chatID,
+		DisableNotification: media.disableNotification,
+		ReplyMarkup:         replyMarkup,
+	}
+
+	msgs, err := b.sendParts(followUp, parts)
+	return append([]*Message{msg}, msgs...), err
+}
@@ -0,0 +1,140 @@
+package telegram_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/internal/telegramtest"
+)
+
+func TestSendLongMessage(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	bot := rec.Bot()
+	keyboard := &telegram.InlineKeyboardMarkup{}
+
+	text := strings.Repeat("a", 4000) + "\n" + strings.Repeat("b", 4000) + "\n" + strings.Repeat("c", 200)
+	msgs, err := bot.SendLongMessage(telegram.NewMessage("1", text).ReplyTo(5).Keyboard(keyboard))
+	assert.Nil(t, err)
+	assert.Len(t, msgs, 3)
+
+	reqs := rec.Requests()
+	assert.Len(t, reqs, 3)
+
+	first := reqs[0].Req.(*telegram.SendMessageRequest)
+	assert.Equal(t, strings.Repeat("a", 4000), first.Text)
+	assert.Equal(t, 5, first.ReplyToMessageID)
+	assert.Nil(t, first.ReplyMarkup)
+
+	last := reqs[2].Req.(*telegram.SendMessageRequest)
+	assert.Equal(t, strings.Repeat("c", 200), last.Text)
+	assert.Equal(t, 0, last.ReplyToMessageID)
+	assert.Equal(t, keyboard, last.ReplyMarkup)
+
+	_, err = bot.SendLongMessage(telegram.NewMessage("1", text).WithParseMode(telegram.ParseModeMarkdown))
+	assert.Equal(t, telegram.ErrLongMarkup, err)
+}
+
+func TestSendLongMessageHTML(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	bot := rec.Bot()
+
+	text := "<b>" + strings.Repeat("a", 4000) + "\n" + strings.Repeat("b", 200) + "</b> &amp; <i>c</i>"
+	req := telegram.NewMessage("1", text).HTML()
+	msgs, err := bot.SendLongMessage(req)
+	assert.Nil(t, err)
+	assert.Len(t, msgs, 2)
+	assert.Equal(t, text, req.Text)
+	assert.Equal(t, telegram.ParseModeHTML, req.ParseMode)
+
+	reqs := rec.Requests()
+	first := reqs[0].Req.(*telegram.SendMessageRequest)
+	assert.Equal(t, strings.Repeat("a", 4000), first.Text)
+	assert.Equal(t, telegram.ParseMode(""), first.ParseMode)
+	assert.Equal(t, []telegram.MessageEntity{
+		{Type: telegram.MessageEntityTypeBold, Offset: 0, Length: 4000},
+	}, first.Entities)
+
+	last := reqs[1].Req.(*telegram.SendMessageRequest)
+	assert.Equal(t, strings.Repeat("b", 200)+" & c", last.Text)
+	assert.Equal(t, []telegram.MessageEntity{
+		{Type: telegram.MessageEntityTypeBold, Offset: 0, Length: 200},
+		{Type: telegram.MessageEntityTypeItalic, Offset: 203, Length: 1},
+	}, last.Entities)
+
+	_, err = bot.SendLongMessage(telegram.NewMessage("1", "<b>"+text).HTML())
+	assert.True(t, errors.Is(err, telegram.ErrInvalidMarkup))
+}
+
+func TestSendMediaLongCaption(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	bot := rec.Bot()
+	keyboard := &telegram.InlineKeyboardMarkup{}
+
+	caption := strings.Repeat("word ", 300)
+	msgs, err := bot.SendMediaLongCaption(&telegram.SendPhotoRequest{
+		ChatID:      "1",
+		Photo:       "file_id",
+		Caption:     caption,
+		ReplyMarkup: keyboard,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, msgs, 2)
+
+	reqs := rec.Requests()
+	photo := reqs[0].Req.(*telegram.SendPhotoRequest)
+	assert.True(t, telegram.UTF16Len(photo.Caption) <= telegram.MaxCaptionLength)
+	assert.Nil(t, photo.ReplyMarkup)
+
+	followUp := reqs[1].Req.(*telegram.SendMessageRequest)
+	assert.Equal(t, caption, photo.Caption+" "+followUp.Text)
+	assert.Equal(t, keyboard, followUp.ReplyMarkup)
+
+	// the caller's request is not modified
+	req := &telegram.SendPhotoRequest{
+		ChatID:      "1",
+		Photo:       "file_id",
+		Caption:     "<i>" + caption + "</i>",
+		ParseMode:   telegram.ParseModeHTML,
+		ReplyMarkup: keyboard,
+	}
+	_, err = bot.SendMediaLongCaption(req)
+	assert.Nil(t, err)
+	assert.Equal(t, "<i>"+caption+"</i>", req.Caption)
+	assert.Equal(t, telegram.ParseModeHTML, req.ParseMode)
+	assert.Nil(t, req.CaptionEntities)
+	assert.Equal(t, keyboard, req.ReplyMarkup)
+
+	followUp = rec.Last().Req.(*telegram.SendMessageRequest)
+	assert.Equal(t, telegram.MessageEntityTypeItalic, followUp.Entities[0].Type)
+
+	_, err = bot.SendMediaLongCaption(&telegram.SendMessageRequest{})
+	assert.NotNil(t, err)
+}
+
+func TestSendMediaLongCaptionEmptyTail(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	bot := rec.Bot()
+	keyboard := &telegram.InlineKeyboardMarkup{}
+
+	// only the whitespace is left after the cut
+	caption := strings.Repeat("a", telegram.MaxCaptionLength) + "\n\n\n"
+	msgs, err := bot.SendMediaLongCaption(&telegram.SendPhotoRequest{
+		ChatID:      "1",
+		Photo:       "file_id",
+		Caption:     caption,
+		ReplyMarkup: keyboard,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, msgs, 1)
+
+	reqs := rec.Requests()
+	assert.Len(t, reqs, 1)
+
+	photo := reqs[0].Req.(*telegram.SendPhotoRequest)
+	assert.Equal(t, strings.Repeat("a", telegram.MaxCaptionLength), photo.Caption)
+	assert.Equal(t, keyboard, photo.ReplyMarkup)
+}
@@ -0,0 +1,156 @@
+package markup
+
+import (
+	"github.com/petuhovskiy/telegram"
+)
+
+// ReplyKeyboardMarkup is telegram.ReplyKeyboardMarkup with input field
+// placeholder, which is not present in the generated type yet.
+type ReplyKeyboardMarkup struct {
+	telegram.ReplyKeyboardMarkup
+	InputFieldPlaceholder string `json:"input_field_placeholder,omitempty"`
+}
+
+func ReplyKeyboard(keyboard [][]telegram.KeyboardButton) *ReplyKeyboardMarkup {
+	return &ReplyKeyboardMarkup{
+		ReplyKeyboardMarkup: telegram.ReplyKeyboardMarkup{
+			Keyboard: keyboard,
+		},
+	}
+}
+
+// Resize requests clients to fit the keyboard height to the buttons.
+func (m *ReplyKeyboardMarkup) Resize() *ReplyKeyboardMarkup {
+	m.ResizeKeyboard = true
+	return m
+}
+
+// OneTime requests clients to hide the keyboard after it's used.
+func (m *ReplyKeyboardMarkup) OneTime() *ReplyKeyboardMarkup {
+	m.OneTimeKeyboard = true
+	return m
+}
+
+// ForSelected shows the keyboard only to mentioned users and the sender of the replied message.
+func (m *ReplyKeyboardMarkup) ForSelected() *ReplyKeyboardMarkup {
+	m.Selective = true
+	return m
+}
+
+// Placeholder sets text, which is shown in the input field when the keyboard is active.
+func (m *ReplyKeyboardMarkup) Placeholder(text string) *ReplyKeyboardMarkup {
+	m.InputFieldPlaceholder = text
+	return m
+}
+
+func Button(text string) telegram.KeyboardButton {
+	return telegram.KeyboardButton{
+		Text: text,
+	}
+}
+
+// ContactButton sends user's phone number, available in private chats only.
+func ContactButton(text string) telegram.KeyboardButton {
+	return telegram.KeyboardButton{
+		Text:           text,
+		RequestContact: true,
+	}
+}
+
+// LocationButton sends user's location, available in private chats only.
+func LocationButton(text string) telegram.KeyboardButton {
+	return telegram.KeyboardButton{
+		Text:            text,
+		RequestLocation: true,
+	}
+}
+
+// PollButton asks user to create a poll. Empty poll type allows any poll.
+func PollButton(text string, pollType telegram.PollType) telegram.KeyboardButton {
+	poll := &telegram.KeyboardButtonPollType{}
+	if pollType != "" {
+		poll.Type = telegram.String(string(pollType))
+	}
+
+	return telegram.KeyboardButton{
+		Text:        text,
+		RequestPoll: poll,
+	}
+}
+
+// RemoveKeyboard hides current reply keyboard.
+func RemoveKeyboard() *telegram.ReplyKeyboardRemove {
+	return &telegram.ReplyKeyboardRemove{
+		RemoveKeyboard: true,
+	}
+}
+
+// ForceReplyMarkup is telegram.ForceReply with input field placeholder,
+// which is not present in the generated type yet.
+type ForceReplyMarkup struct {
+	telegram.ForceReply
+	InputFieldPlaceholder string `json:"input_field_placeholder,omitempty"`
+}
+
+// ForceReply shows reply interface to the user, placeholder can be empty.
+func ForceReply(placeholder string) *ForceReplyMarkup {
+	return &ForceReplyMarkup{
+		ForceReply: telegram.ForceReply{
+			ForceReply: true,
+		},
+		InputFieldPlaceholder: placeholder,
+	}
+}
+
+// gridRows returns [start, end) of each row, the last width is used for all
+// remaining rows.
+func gridRows(widths []int, count int) [][2]int {
+	var rows [][2]int
+	for i, row := 0, 0; i < count; row++ {
+		width := 1
+		if len(widths) > 0 {
+			width = widths[len(widths)-1]
+			if row < len(widths) {
+				width = widths[row]
+			}
+		}
+		if width < 1 {
+			width = 1
+		}
+
+		end := i + width
+		if end > count {
+			end = count
+		}
+
+		rows = append(rows, [2]int{i, end})
+		i = end
+	}
+
+	return rows
+}
+
+// KeyboardGrid puts buttons into rows with given widths, e.g. widths 1, 3
+// make one wide button and rows of three buttons below it.
+func KeyboardGrid(widths []int, buttons []telegram.KeyboardButton) [][]telegram.KeyboardButton {
+	var keyboard [][]telegram.KeyboardButton
+	for _, row := range gridRows(widths, len(buttons)) {
+		keyboard = append(keyboard, buttons[row[0]:row[1]])
+	}
+
+	return keyboard
+}
+
+// InlineKeyboardGrid is KeyboardGrid for inline buttons.
+func InlineKeyboardGrid(widths []int, buttons []telegram.InlineKeyboardButton) [][]telegram.InlineKeyboardButton {
+	var keyboard [][]telegram.InlineKeyboardButton
+	for _, row := range gridRows(widths, len(buttons)) {
+		keyboard = append(keyboard, buttons[row[0]:row[1]])
+	}
+
+	return keyboard
+}
+
+func KeyboardRows(maxCountInRow int, buttons []telegram.KeyboardButton) [][]telegram.KeyboardButton {
+	return KeyboardGrid([]int{maxCountInRow}, buttons)
+}
@@ -0,0 +1,44 @@
+package markup
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+func TestReplyKeyboard(t *testing.T) {
+	kb := ReplyKeyboard(KeyboardRows(2, []telegram.KeyboardButton{
+		Button("a"),
+		ContactButton("phone"),
+		LocationButton("location"),
+		PollButton("quiz", telegram.PollTypeQuiz),
+	})).Resize().Placeholder("choose")
+
+	j, err := json.Marshal(kb)
+	assert.Nil(t, err)
+	assert.Equal(t,
+		`{"keyboard":[[{"text":"a"},{"text":"phone","request_contact":true}],[{"text":"location","request_location":true},{"text":"quiz","request_poll":{"type":"quiz"}}]],"resize_keyboard":true,"input_field_placeholder":"choose"}`,
+		string(j),
+	)
+
+	j, err = json.Marshal(ForceReply("reply"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"force_reply":true,"input_field_placeholder":"reply"}`, string(j))
+
+	j, err = json.Marshal(RemoveKeyboard())
+	assert.Nil(t, err)
+	assert.Equal(t, `{"remove_keyboard":true}`, string(j))
+}
+
+func TestKeyboardGrid(t *testing.T) {
+	buttons := []telegram.KeyboardButton{Button("1"), Button("2"), Button("3"), Button("4"), Button("5"), Button("6")}
+
+	grid := KeyboardGrid([]int{1, 3}, buttons)
+	assert.Equal(t, [][]telegram.KeyboardButton{buttons[:1], buttons[1:4], buttons[4:]}, grid)
+
+	assert.Nil(t, KeyboardGrid([]int{2}, nil))
+	assert.Len(t, InlineKeyboardGrid(nil, make([]telegram.InlineKeyboardButton, 3)), 3)
+}
@@ -0,0 +1,25 @@
+package telegram
+
+// Some optional fields are pointers, because their zero value is meaningful,
+// e.g. ChatPermissions with false values or Poll.CorrectOptionID equal to 0.
+// These helpers make it possible to set them in a single expression.
+
+// Bool returns a pointer to the given value.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Int returns a pointer to the given value.
+func Int(v int) *int {
+	return &v
+}
+
+// Float64 returns a pointer to the given value.
+func Float64(v float64) *float64 {
+	return &v
+}
+
+// String returns a pointer to the given value.
+func String(v string) *string {
+	return &v
+}
@@ -0,0 +1,300 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// ErrInvalidMarkup is returned when the text can't be parsed in the parse mode.
+var ErrInvalidMarkup = errors.New("invalid markup")
+
+// entityBuilder collects plain text and entities, offsets are in UTF-16
+// code units.
+type entityBuilder struct {
+	text     strings.Builder
+	offset   int
+	open     []MessageEntity
+	tags     []string
+	entities []MessageEntity
+}
+
+func (b *entityBuilder) write(s string) {
+	b.text.WriteString(s)
+	b.offset += UTF16Len(s)
+}
+
+func (b *entityBuilder) push(tag string, e MessageEntity) {
+	e.Offset = b.offset
+	b.open = append(b.open, e)
+	b.tags = append(b.tags, tag)
+}
+
+// top returns tag of the innermost open entity, empty if there are none.
+func (b *entityBuilder) top() string {
+	if len(b.tags) == 0 {
+		return ""
+	}
+	return b.tags[len(b.tags)-1]
+}
+
+// pop closes the innermost entity, empty entities are dropped.
+func (b *entityBuilder) pop() *MessageEntity {
+	e := b.open[len(b.open)-1]
+	b.open = b.open[:len(b.open)-1]
+	b.tags = b.tags[:len(b.tags)-1]
+
+	e.Length = b.offset - e.Offset
+	if e.Length == 0 {
+		return nil
+	}
+
+	b.entities = append(b.entities, e)
+	return &b.entities[len(b.entities)-1]
+}
+
+func (b *entityBuilder) result() (FormattedText, error) {
+	if len(b.open) != 0 {
+		return FormattedText{}, fmt.Errorf("%w: unclosed %s", ErrInvalidMarkup, b.top())
+	}
+
+	sortEntities(b.entities)
+	return FormattedText{Text: b.text.String(), Entities: b.entities}, nil
+}
+
+// linkEntity returns text_link entity, or text_mention for tg://user links.
+func linkEntity(url string) MessageEntity {
+	if s := strings.TrimPrefix(url, "tg://user?id="); s != url {
+		if id, err := strconv.Atoi(s); err == nil {
+			return MessageEntity{Type: MessageEntityTypeTextMention, User: &User{ID: id}}
+		}
+	}
+	return MessageEntity{Type: MessageEntityTypeTextLink, URL: url}
+}
+
+var htmlEntityTypes = map[string]MessageEntityType{
+	"b":          MessageEntityTypeBold,
+	"strong":     MessageEntityTypeBold,
+	"i":          MessageEntityTypeItalic,
+	"em":         MessageEntityTypeItalic,
+	"u":          MessageEntityTypeUnderline,
+	"ins":        MessageEntityTypeUnderline,
+	"s":          MessageEntityTypeStrikethrough,
+	"strike":     MessageEntityTypeStrikethrough,
+	"del":        MessageEntityTypeStrikethrough,
+	"tg-spoiler": MessageEntityTypeSpoiler,
+	"code":       MessageEntityTypeCode,
+	"pre":        MessageEntityTypePre,
+}
+
+func htmlAttr(t html.Token, name string) string {
+	for _, a := range t.Attr {
+		if a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// ParseHTML converts text with markup of ParseModeHTML to plain text with
+// entities. Only the tags, which are supported by Telegram, are accepted.
+func ParseHTML(text string) (FormattedText, error) {
+	var b entityBuilder
+	z := html.NewTokenizer(strings.NewReader(text))
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if z.Err() != io.EOF {
+				return FormattedText{}, z.Err()
+			}
+			return b.result()
+
+		case html.TextToken:
+			b.write(string(z.Text()))
+
+		case html.StartTagToken:
+			t := z.Token()
+			typ, ok := htmlEntityTypes[t.Data]
+
+			switch {
+			case t.Data == "a":
+				b.push(t.Data, linkEntity(htmlAttr(t, "href")))
+			case t.Data == "span" && htmlAttr(t, "class") == "tg-spoiler":
+				b.push(t.Data, MessageEntity{Type: MessageEntityTypeSpoiler})
+			case t.Data == "code" && b.top() == "pre":
+				// <pre><code class="language-go"> sets language of the block
+				pre := &b.open[len(b.open)-1]
+				pre.Language = strings.TrimPrefix(htmlAttr(t, "class"), "language-")
+				b.push(t.Data, MessageEntity{})
+			case ok:
+				b.push(t.Data, MessageEntity{Type: typ})
+			default:
+				return FormattedText{}, fmt.Errorf("%w: unsupported tag <%s>", ErrInvalidMarkup, t.Data)
+			}
+
+		case html.EndTagToken:
+			t := z.Token()
+			if b.top() != t.Data {
+				return FormattedText{}, fmt.Errorf("%w: unexpected </%s>", ErrInvalidMarkup, t.Data)
+			}
+
+			inPre := t.Data == "code" && len(b.tags) > 1 && b.tags[len(b.tags)-2] == "pre"
+			e := b.pop()
+			if inPre && e != nil {
+				// code inside pre is a part of the pre entity
+				b.entities = b.entities[:len(b.entities)-1]
+			}
+
+		case html.SelfClosingTagToken:
+			return FormattedText{}, fmt.Errorf("%w: unsupported tag <%s/>", ErrInvalidMarkup, z.Token().Data)
+		}
+	}
+}
+
+var markdownV2Markers = map[string]MessageEntityType{
+	"*":  MessageEntityTypeBold,
+	"_":  MessageEntityTypeItalic,
+	"__": MessageEntityTypeUnderline,
+	"~":  MessageEntityTypeStrikethrough,
+	"||": MessageEntityTypeSpoiler,
+}
+
+// readUntil returns text until the unescaped delimiter and the position after
+// the delimiter, backslash escapes any character.
+func readUntil(s []rune, pos int, delim string) (string, int, error) {
+	var res strings.Builder
+	for pos < len(s) {
+		if s[pos] == '\\' && pos+1 < len(s) {
+			res.WriteRune(s[pos+1])
+			pos += 2
+			continue
+		}
+		if strings.HasPrefix(string(s[pos:]), delim) {
+			return res.String(), pos + len([]rune(delim)), nil
+		}
+		res.WriteRune(s[pos])
+		pos++
+	}
+	return "", 0, fmt.Errorf("%w: missing %s", ErrInvalidMarkup, delim)
+}
+
+// ParseMarkdownV2 converts text with markup of ParseModeMarkdownV2 to plain
+// text with entities. Reserved characters, which are not escaped, are kept
+// as text, unless they're markers of entities.
+func ParseMarkdownV2(text string) (FormattedText, error) {
+	var b entityBuilder
+	s := []rune(text)
+
+	for pos := 0; pos < len(s); {
+		c := s[pos]
+
+		switch {
+		case c == '\\' && pos+1 < len(s):
+			b.write(string(s[pos+1]))
+			pos += 2
+
+		case c == '\r':
+			// used to separate italic and underline markers
+			pos++
+
+		case strings.HasPrefix(string(s[pos:]), "```"):
+			code, next, err := readUntil(s, pos+3, "```")
+			if err != nil {
+				return FormattedText{}, err
+			}
+
+			e := MessageEntity{Type: MessageEntityTypePre}
+			if i := strings.IndexByte(code, '\n'); i >= 0 {
+				e.Language, code = code[:i], code[i+1:]
+			}
+			b.push("```", e)
+			b.write(code)
+			b.pop()
+			pos = next
+
+		case c == '`':
+			code, next, err := readUntil(s, pos+1, "`")
+			if err != nil {
+				return FormattedText{}, err
+			}
+
+			b.push("`", MessageEntity{Type: MessageEntityTypeCode})
+			b.write(code)
+			b.pop()
+			pos = next
+
+		case c == '[':
+			b.push("[", MessageEntity{})
+			pos++
+
+		case c == ']' && b.top() == "[":
+			if pos+1 >= len(s) || s[pos+1] != '(' {
+				return FormattedText{}, fmt.Errorf("%w: link without url", ErrInvalidMarkup)
+			}
+
+			url, next, err := readUntil(s, pos+2, ")")
+			if err != nil {
+				return FormattedText{}, err
+			}
+
+			link := linkEntity(url)
+			b.open[len(b.open)-1].Type = link.Type
+			b.open[len(b.open)-1].URL = link.URL
+			b.open[len(b.open)-1].User = link.User
+			b.pop()
+			pos = next
+
+		default:
+			marker := string(c)
+			if pos+1 < len(s) && (c == '_' || c == '|') && s[pos+1] == c {
+				marker += string(c)
+			}
+
+			typ, ok := markdownV2Markers[marker]
+			if !ok {
+				b.write(string(c))
+				pos++
+				continue
+			}
+
+			if b.top() == marker {
+				b.pop()
+			} else {
+				b.push(marker, MessageEntity{Type: typ})
+			}
+			pos += len(marker)
+		}
+	}
+
+	return b.result()
+}
+
+// ParseMarkup converts text with markup to plain text with entities. Empty
+// parse mode returns the text as is, legacy Markdown is not supported.
+func ParseMarkup(text string, mode ParseMode) (FormattedText, error) {
+	switch mode {
+	case "":
+		return FormattedText{Text: text}, nil
+	case ParseModeHTML:
+		return ParseHTML(text)
+	case ParseModeMarkdownV2:
+		return ParseMarkdownV2(text)
+	}
+	return FormattedText{}, fmt.Errorf("%w: parse mode %s is not supported", ErrInvalidMarkup, mode)
+}
+
+// sortEntities sorts entities by offset, outer entities go first.
+func sortEntities(entities []MessageEntity) {
+	sort.SliceStable(entities, func(i, j int) bool {
+		if entities[i].Offset != entities[j].Offset {
+			return entities[i].Offset < entities[j].Offset
+		}
+		return entities[i].Length > entities[j].Length
+	})
+}
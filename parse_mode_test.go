@@ -0,0 +1,53 @@
+package telegram
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHTML(t *testing.T) {
+	res, err := ParseHTML(`1 &lt; 2 <b>bold <i>both</i></b> <a href="https://example.com/?a=1&amp;b=2">link</a> ` +
+		`<a href="tg://user?id=42">user</a> <tg-spoiler>s</tg-spoiler><pre><code class="language-go">x</code></pre>😀<u></u><s>d</s>`)
+	assert.Nil(t, err)
+	assert.Equal(t, "1 < 2 bold both link user sx😀d", res.Text)
+	assert.Equal(t, []MessageEntity{
+		{Type: MessageEntityTypeBold, Offset: 6, Length: 9},
+		{Type: MessageEntityTypeItalic, Offset: 11, Length: 4},
+		{Type: MessageEntityTypeTextLink, Offset: 16, Length: 4, URL: "https://example.com/?a=1&b=2"},
+		{Type: MessageEntityTypeTextMention, Offset: 21, Length: 4, User: &User{ID: 42}},
+		{Type: MessageEntityTypeSpoiler, Offset: 26, Length: 1},
+		{Type: MessageEntityTypePre, Offset: 27, Length: 1, Language: "go"},
+		{Type: MessageEntityTypeStrikethrough, Offset: 30, Length: 1},
+	}, res.Entities)
+
+	for _, text := range []string{"<b>a", "<b>a</i>", "<div>a</div>", "a<br/>"} {
+		_, err := ParseHTML(text)
+		assert.True(t, errors.Is(err, ErrInvalidMarkup), text)
+	}
+}
+
+func TestParseMarkdownV2(t *testing.T) {
+	res, err := ParseMarkdownV2("Price: 1\\.5$ *a\\_b _c_* [\\[link\\]](https://example.com/a_(b\\)) " +
+		"[user](tg://user?id=42) `a\\`b` ||s|| _\r__iu__\r_ ~x~\n```go\nfmt.Println()```")
+	assert.Nil(t, err)
+	assert.Equal(t, "Price: 1.5$ a_b c [link] user a`b s iu x\nfmt.Println()", res.Text)
+	assert.Equal(t, []MessageEntity{
+		{Type: MessageEntityTypeBold, Offset: 12, Length: 5},
+		{Type: MessageEntityTypeItalic, Offset: 16, Length: 1},
+		{Type: MessageEntityTypeTextLink, Offset: 18, Length: 6, URL: "https://example.com/a_(b)"},
+		{Type: MessageEntityTypeTextMention, Offset: 25, Length: 4, User: &User{ID: 42}},
+		{Type: MessageEntityTypeCode, Offset: 30, Length: 3},
+		{Type: MessageEntityTypeSpoiler, Offset: 34, Length: 1},
+		{Type: MessageEntityTypeUnderline, Offset: 36, Length: 2},
+		{Type: MessageEntityTypeItalic, Offset: 36, Length: 2},
+		{Type: MessageEntityTypeStrikethrough, Offset: 39, Length: 1},
+		{Type: MessageEntityTypePre, Offset: 41, Length: 13, Language: "go"},
+	}, res.Entities)
+
+	for _, text := range []string{"*a", "`a", "[a]b", "*a _b* c_"} {
+		_, err := ParseMarkdownV2(text)
+		assert.True(t, errors.Is(err, ErrInvalidMarkup), text)
+	}
+}
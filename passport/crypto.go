@@ -0,0 +1,187 @@
+package passport
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+var (
+	ErrInvalidKey     = errors.New("invalid RSA private key")
+	ErrInvalidData    = errors.New("invalid encrypted data")
+	ErrHashMismatch   = errors.New("decrypted data hash mismatch")
+	ErrNoCredentials  = errors.New("no credentials for the element")
+	ErrUnknownElement = errors.New("unknown passport element type")
+)
+
+// ParsePrivateKey parses PEM encoded RSA private key in PKCS #1 or PKCS #8 form.
+func ParsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidKey
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+	return rsaKey, nil
+}
+
+// DecryptData decrypts data with AES-256-CBC, the key and iv are derived
+// from SHA512(secret + hash). The hash is SHA256 of the decrypted data,
+// which starts with padding of 32-255 bytes, the first byte is its length.
+func DecryptData(data, secret, hash []byte) ([]byte, error) {
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, ErrInvalidData
+	}
+
+	digest := sha512.Sum512(append(append([]byte{}, secret...), hash...))
+	key, iv := digest[:32], digest[32:48]
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(res, data)
+
+	sum := sha256.Sum256(res)
+	if !bytes.Equal(sum[:], hash) {
+		return nil, ErrHashMismatch
+	}
+
+	padding := int(res[0])
+	if padding < 32 || padding >= len(res) {
+		return nil, ErrInvalidData
+	}
+	return res[padding:], nil
+}
+
+func decodeBase64(s string) ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidData
+	}
+	return b, nil
+}
+
+// DataCredentials decrypt the data field of the element.
+type DataCredentials struct {
+	DataHash string `json:"data_hash"`
+	Secret   string `json:"secret"`
+}
+
+// Decrypt decrypts base64 encoded data of the element.
+func (c *DataCredentials) Decrypt(data string) ([]byte, error) {
+	encrypted, err := decodeBase64(data)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := decodeBase64(c.Secret)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := decodeBase64(c.DataHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecryptData(encrypted, secret, hash)
+}
+
+// FileCredentials decrypt the file content.
+type FileCredentials struct {
+	FileHash string `json:"file_hash"`
+	Secret   string `json:"secret"`
+}
+
+// Decrypt decrypts downloaded content of the file.
+func (c *FileCredentials) Decrypt(content []byte) ([]byte, error) {
+	secret, err := decodeBase64(c.Secret)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := decodeBase64(c.FileHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecryptData(content, secret, hash)
+}
+
+// SecureValue contains credentials of the element data and files.
+type SecureValue struct {
+	Data        *DataCredentials  `json:"data,omitempty"`
+	FrontSide   *FileCredentials  `json:"front_side,omitempty"`
+	ReverseSide *FileCredentials  `json:"reverse_side,omitempty"`
+	Selfie      *FileCredentials  `json:"selfie,omitempty"`
+	Translation []FileCredentials `json:"translation,omitempty"`
+	Files       []FileCredentials `json:"files,omitempty"`
+}
+
+// Credentials are decrypted EncryptedCredentials.
+type Credentials struct {
+	// SecureData maps element types to their credentials.
+	SecureData map[string]*SecureValue `json:"secure_data"`
+
+	// Nonce is the value from the authorization request, it must be checked
+	// to prevent replay attacks.
+	Nonce string `json:"nonce"`
+}
+
+// DecryptCredentials decrypts the secret with the bot private key using
+// RSA-OAEP, and then the credentials data.
+func DecryptCredentials(key *rsa.PrivateKey, c *telegram.EncryptedCredentials) (*Credentials, error) {
+	encryptedSecret, err := decodeBase64(c.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := rsa.DecryptOAEP(sha1.New(), nil, key, encryptedSecret, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := decodeBase64(c.Data)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := decodeBase64(c.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	plain, err := DecryptData(data, secret, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Credentials
+	err = json.Unmarshal(plain, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
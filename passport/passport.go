@@ -0,0 +1,274 @@
+package passport
+
+import (
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+// Types of the passport elements.
+const (
+	TypePersonalDetails       = "personal_details"
+	TypePassport              = "passport"
+	TypeDriverLicense         = "driver_license"
+	TypeIdentityCard          = "identity_card"
+	TypeInternalPassport      = "internal_passport"
+	TypeAddress               = "address"
+	TypeUtilityBill           = "utility_bill"
+	TypeBankStatement         = "bank_statement"
+	TypeRentalAgreement       = "rental_agreement"
+	TypePassportRegistration  = "passport_registration"
+	TypeTemporaryRegistration = "temporary_registration"
+	TypePhoneNumber           = "phone_number"
+	TypeEmail                 = "email"
+)
+
+// PersonalDetails is the data of the personal_details element.
+type PersonalDetails struct {
+	FirstName            string `json:"first_name"`
+	LastName             string `json:"last_name"`
+	MiddleName           string `json:"middle_name,omitempty"`
+	BirthDate            string `json:"birth_date"` // DD.MM.YYYY
+	Gender               string `json:"gender"`     // male or female
+	CountryCode          string `json:"country_code"`
+	ResidenceCountryCode string `json:"residence_country_code"`
+	FirstNameNative      string `json:"first_name_native"`
+	LastNameNative       string `json:"last_name_native"`
+	MiddleNameNative     string `json:"middle_name_native,omitempty"`
+}
+
+// ResidentialAddress is the data of the address element.
+type ResidentialAddress struct {
+	StreetLine1 string `json:"street_line1"`
+	StreetLine2 string `json:"street_line2,omitempty"`
+	City        string `json:"city"`
+	State       string `json:"state,omitempty"`
+	CountryCode string `json:"country_code"`
+	PostCode    string `json:"post_code"`
+}
+
+// IDDocumentData is the data of the identity documents: passport, driver
+// license, identity card and internal passport.
+type IDDocumentData struct {
+	DocumentNo string `json:"document_no"`
+	ExpiryDate string `json:"expiry_date,omitempty"` // DD.MM.YYYY
+}
+
+// File is the encrypted file with its credentials.
+type File struct {
+	telegram.PassportFile
+	Credentials FileCredentials
+}
+
+// Decrypt decrypts downloaded content of the file.
+func (f *File) Decrypt(content []byte) ([]byte, error) {
+	return f.Credentials.Decrypt(content)
+}
+
+// Download downloads the file with the http client of the bot and decrypts it.
+func (f *File) Download(bot *telegram.Bot) ([]byte, error) {
+	url, err := bot.GetFileURL(f.FileID, func(tempURL string) (string, error) {
+		return tempURL, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := bot.Client().Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download passport file, status=%v", resp.Status)
+	}
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Decrypt(content)
+}
+
+// Document is the identity document or the proof of address. Data is set
+// only for identity documents.
+type Document struct {
+	Type string
+	Data *IDDocumentData
+
+	FrontSide   *File
+	ReverseSide *File
+	Selfie      *File
+	Files       []File
+	Translation []File
+}
+
+// Passport is the decrypted PassportData.
+type Passport struct {
+	Nonce string
+
+	PersonalDetails *PersonalDetails
+	Address         *ResidentialAddress
+
+	// IdentityDocuments are passport, driver license, identity card and
+	// internal passport.
+	IdentityDocuments []Document
+
+	// AddressDocuments are utility bill, bank statement, rental agreement,
+	// passport registration and temporary registration.
+	AddressDocuments []Document
+
+	PhoneNumber string
+	Email       string
+}
+
+// Decryptor decrypts passport data with the bot private key.
+type Decryptor struct {
+	key *rsa.PrivateKey
+}
+
+func NewDecryptor(key *rsa.PrivateKey) *Decryptor {
+	return &Decryptor{
+		key: key,
+	}
+}
+
+func file(f *telegram.PassportFile, c *FileCredentials) (*File, error) {
+	if f == nil {
+		return nil, nil
+	}
+	if c == nil {
+		return nil, fmt.Errorf("%w: file %s", ErrNoCredentials, f.FileID)
+	}
+	return &File{PassportFile: *f, Credentials: *c}, nil
+}
+
+func files(list []telegram.PassportFile, creds []FileCredentials) ([]File, error) {
+	if len(list) != len(creds) {
+		return nil, fmt.Errorf("%w: %d files, %d credentials", ErrNoCredentials, len(list), len(creds))
+	}
+
+	var res []File
+	for i := range list {
+		res = append(res, File{PassportFile: list[i], Credentials: creds[i]})
+	}
+	return res, nil
+}
+
+// decryptJSON decrypts data of the element into v.
+func decryptJSON(data string, c *DataCredentials, v interface{}) error {
+	if c == nil {
+		return ErrNoCredentials
+	}
+
+	plain, err := c.Decrypt(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(plain, v)
+}
+
+func document(e *telegram.EncryptedPassportElement, v *SecureValue) (*Document, error) {
+	doc := &Document{Type: e.Type}
+
+	if e.Data != "" {
+		doc.Data = &IDDocumentData{}
+		err := decryptJSON(e.Data, v.Data, doc.Data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	if doc.FrontSide, err = file(e.FrontSide, v.FrontSide); err != nil {
+		return nil, err
+	}
+	if doc.ReverseSide, err = file(e.ReverseSide, v.ReverseSide); err != nil {
+		return nil, err
+	}
+	if doc.Selfie, err = file(e.Selfie, v.Selfie); err != nil {
+		return nil, err
+	}
+	if doc.Files, err = files(e.Files, v.Files); err != nil {
+		return nil, err
+	}
+	if doc.Translation, err = files(e.Translation, v.Translation); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// Decrypt decrypts the credentials and the data of all elements. Files are
+// only described, use File.Download or File.Decrypt to get their content.
+func (d *Decryptor) Decrypt(data *telegram.PassportData) (*Passport, error) {
+	if data.Credentials == nil {
+		return nil, ErrNoCredentials
+	}
+
+	creds, err := DecryptCredentials(d.key, data.Credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Passport{
+		Nonce: creds.Nonce,
+	}
+
+	for i := range data.Data {
+		e := &data.Data[i]
+
+		switch e.Type {
+		case TypePhoneNumber:
+			res.PhoneNumber = e.PhoneNumber
+			continue
+		case TypeEmail:
+			res.Email = e.Email
+			continue
+		}
+
+		v := creds.SecureData[e.Type]
+		if v == nil {
+			return nil, fmt.Errorf("%w: %s", ErrNoCredentials, e.Type)
+		}
+
+		switch e.Type {
+		case TypePersonalDetails:
+			res.PersonalDetails = &PersonalDetails{}
+			err = decryptJSON(e.Data, v.Data, res.PersonalDetails)
+
+		case TypeAddress:
+			res.Address = &ResidentialAddress{}
+			err = decryptJSON(e.Data, v.Data, res.Address)
+
+		case TypePassport, TypeDriverLicense, TypeIdentityCard, TypeInternalPassport:
+			var doc *Document
+			doc, err = document(e, v)
+			if err == nil {
+				res.IdentityDocuments = append(res.IdentityDocuments, *doc)
+			}
+
+		case TypeUtilityBill, TypeBankStatement, TypeRentalAgreement, TypePassportRegistration, TypeTemporaryRegistration:
+			var doc *Document
+			doc, err = document(e, v)
+			if err == nil {
+				res.AddressDocuments = append(res.AddressDocuments, *doc)
+			}
+
+		default:
+			err = fmt.Errorf("%w: %s", ErrUnknownElement, e.Type)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", e.Type, err)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,170 @@
+package passport
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+// encrypt does the same as Telegram, returns data, secret and hash.
+func encrypt(t *testing.T, plain []byte) ([]byte, []byte, []byte) {
+	padding := 32 + (16-len(plain)%16)%16
+	padded := make([]byte, padding+len(plain))
+	_, err := rand.Read(padded[:padding])
+	assert.Nil(t, err)
+	padded[0] = byte(padding)
+	copy(padded[padding:], plain)
+
+	return encryptPadded(t, padded)
+}
+
+// encryptPadded encrypts data, which already starts with the padding.
+func encryptPadded(t *testing.T, padded []byte) ([]byte, []byte, []byte) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	assert.Nil(t, err)
+
+	sum := sha256.Sum256(padded)
+	hash := sum[:]
+
+	digest := sha512.Sum512(append(append([]byte{}, secret...), hash...))
+	block, err := aes.NewCipher(digest[:32])
+	assert.Nil(t, err)
+
+	data := make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, digest[32:48]).CryptBlocks(data, padded)
+
+	return data, secret, hash
+}
+
+func b64(b []byte) string {
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// encryptJSON returns encrypted base64 data of the element and its credentials.
+func encryptJSON(t *testing.T, v interface{}) (string, *DataCredentials) {
+	plain, err := json.Marshal(v)
+	assert.Nil(t, err)
+
+	data, secret, hash := encrypt(t, plain)
+	return b64(data), &DataCredentials{DataHash: b64(hash), Secret: b64(secret)}
+}
+
+func TestDecrypt(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+
+	details := PersonalDetails{FirstName: "Ivan", LastName: "Petrov", BirthDate: "01.02.1990", CountryCode: "RU"}
+	detailsData, detailsCreds := encryptJSON(t, details)
+
+	address := ResidentialAddress{StreetLine1: "Main st. 1", City: "Moscow", CountryCode: "RU", PostCode: "101000"}
+	addressData, addressCreds := encryptJSON(t, address)
+
+	passportDoc := IDDocumentData{DocumentNo: "1234 567890", ExpiryDate: "01.01.2030"}
+	passportData, passportCreds := encryptJSON(t, passportDoc)
+
+	scan := []byte("jpeg content")
+	scanData, scanSecret, scanHash := encrypt(t, scan)
+
+	creds := Credentials{
+		SecureData: map[string]*SecureValue{
+			TypePersonalDetails: {Data: detailsCreds},
+			TypeAddress:         {Data: addressCreds},
+			TypePassport: {
+				Data:      passportCreds,
+				FrontSide: &FileCredentials{FileHash: b64(scanHash), Secret: b64(scanSecret)},
+			},
+		},
+		Nonce: "nonce",
+	}
+	credsJSON, err := json.Marshal(creds)
+	assert.Nil(t, err)
+
+	credsData, credsSecret, credsHash := encrypt(t, credsJSON)
+	encryptedSecret, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, &key.PublicKey, credsSecret, nil)
+	assert.Nil(t, err)
+
+	data := &telegram.PassportData{
+		Data: []telegram.EncryptedPassportElement{
+			{Type: TypePersonalDetails, Data: detailsData},
+			{Type: TypeAddress, Data: addressData},
+			{Type: TypePassport, Data: passportData, FrontSide: &telegram.PassportFile{FileID: "front"}},
+			{Type: TypePhoneNumber, PhoneNumber: "79990000000"},
+		},
+		Credentials: &telegram.EncryptedCredentials{
+			Data:   b64(credsData),
+			Hash:   b64(credsHash),
+			Secret: b64(encryptedSecret),
+		},
+	}
+
+	res, err := NewDecryptor(key).Decrypt(data)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "nonce", res.Nonce)
+	assert.Equal(t, &details, res.PersonalDetails)
+	assert.Equal(t, &address, res.Address)
+	assert.Equal(t, "79990000000", res.PhoneNumber)
+
+	assert.Len(t, res.IdentityDocuments, 1)
+	doc := res.IdentityDocuments[0]
+	assert.Equal(t, TypePassport, doc.Type)
+	assert.Equal(t, &passportDoc, doc.Data)
+	assert.Equal(t, "front", doc.FrontSide.FileID)
+
+	content, err := doc.FrontSide.Decrypt(scanData)
+	assert.Nil(t, err)
+	assert.Equal(t, scan, content)
+
+	scanData[0] ^= 1
+	_, err = doc.FrontSide.Decrypt(scanData)
+	assert.Equal(t, ErrHashMismatch, err)
+
+	data.Data = append(data.Data, telegram.EncryptedPassportElement{Type: TypeUtilityBill})
+	_, err = NewDecryptor(key).Decrypt(data)
+	assert.True(t, errors.Is(err, ErrNoCredentials))
+}
+
+func TestDecryptDataPadding(t *testing.T) {
+	for _, padding := range []int{0, 16, 31, 48} {
+		padded := make([]byte, 48)
+		padded[0] = byte(padding)
+
+		data, secret, hash := encryptPadded(t, padded)
+		_, err := DecryptData(data, secret, hash)
+		assert.Equal(t, ErrInvalidData, err, padding)
+	}
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	assert.Nil(t, err)
+
+	pkcs1 := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	parsed, err := ParsePrivateKey(pkcs1)
+	assert.Nil(t, err)
+	assert.Equal(t, key.D, parsed.D)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.Nil(t, err)
+	parsed, err = ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	assert.Nil(t, err)
+	assert.Equal(t, key.D, parsed.D)
+
+	_, err = ParsePrivateKey([]byte("garbage"))
+	assert.Equal(t, ErrInvalidKey, err)
+}
@@ -0,0 +1,180 @@
+package payments
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+const (
+	MaxTitleLength       = 32
+	MaxDescriptionLength = 255
+	MaxPayloadLength     = 128
+	MaxSuggestedTips     = 4
+)
+
+var (
+	ErrInvalidTitle       = errors.New("invoice title must be 1-32 characters")
+	ErrInvalidDescription = errors.New("invoice description must be 1-255 characters")
+	ErrInvalidPayload     = errors.New("invoice payload must be 1-128 bytes")
+	ErrInvalidCurrency    = errors.New("currency must be a three-letter ISO 4217 code")
+	ErrNoPrices           = errors.New("invoice must have prices")
+	ErrInvalidPrice       = errors.New("invalid invoice price")
+	ErrInvalidTips        = errors.New("invalid invoice tips")
+)
+
+var currencyRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Invoice builds SendInvoiceRequest. Amounts are integers in the smallest
+// units of the currency, e.g. cents for USD.
+type Invoice struct {
+	req telegram.SendInvoiceRequest
+}
+
+func NewInvoice(title, description, payload, currency string) *Invoice {
+	return &Invoice{
+		req: telegram.SendInvoiceRequest{
+			Title:       title,
+			Description: description,
+			Payload:     payload,
+			Currency:    currency,
+		},
+	}
+}
+
+// Price adds the price portion, amount can be negative for discounts.
+func (i *Invoice) Price(label string, amount int) *Invoice {
+	i.req.Prices = append(i.req.Prices, telegram.LabeledPrice{Label: label, Amount: amount})
+	return i
+}
+
+// Tips allows tips up to max amount, suggested amounts are shown as buttons.
+func (i *Invoice) Tips(max int, suggested ...int) *Invoice {
+	i.req.MaxTipAmount = max
+	i.req.SuggestedTipAmounts = suggested
+	return i
+}
+
+// Photo sets the product photo.
+func (i *Invoice) Photo(url string, width, height int) *Invoice {
+	i.req.PhotoURL = url
+	i.req.PhotoWidth = width
+	i.req.PhotoHeight = height
+	return i
+}
+
+// Shipping requests the shipping address. Flexible price depends on the
+// shipping method, which is chosen by the user in the shipping query.
+func (i *Invoice) Shipping(flexible bool) *Invoice {
+	i.req.NeedShippingAddress = true
+	i.req.IsFlexible = flexible
+	return i
+}
+
+// Need requests the user info.
+func (i *Invoice) Need(name, phone, email bool) *Invoice {
+	i.req.NeedName = name
+	i.req.NeedPhoneNumber = phone
+	i.req.NeedEmail = email
+	return i
+}
+
+// StartParameter enables forwarding of the invoice, the copies have the
+// Pay button, which starts the bot with the parameter.
+func (i *Invoice) StartParameter(param string) *Invoice {
+	i.req.StartParameter = param
+	return i
+}
+
+// Total returns the sum of prices.
+func (i *Invoice) Total() int {
+	total := 0
+	for _, p := range i.req.Prices {
+		total += p.Amount
+	}
+	return total
+}
+
+// Validate checks the invoice against the limits of the Bot API.
+func (i *Invoice) Validate() error {
+	return Validate(&i.req)
+}
+
+// Request returns validated request to send the invoice to the chat.
+func (i *Invoice) Request(chatID, providerToken string) (*telegram.SendInvoiceRequest, error) {
+	err := i.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	req := i.req
+	req.ChatID = chatID
+	req.ProviderToken = providerToken
+	return &req, nil
+}
+
+// Validate checks the invoice request against the limits of the Bot API.
+func Validate(req *telegram.SendInvoiceRequest) error {
+	if n := utf8.RuneCountInString(req.Title); n < 1 || n > MaxTitleLength {
+		return ErrInvalidTitle
+	}
+	if n := utf8.RuneCountInString(req.Description); n < 1 || n > MaxDescriptionLength {
+		return ErrInvalidDescription
+	}
+	if n := len(req.Payload); n < 1 || n > MaxPayloadLength {
+		return ErrInvalidPayload
+	}
+	if !currencyRegexp.MatchString(req.Currency) {
+		return ErrInvalidCurrency
+	}
+
+	if len(req.Prices) == 0 {
+		return ErrNoPrices
+	}
+
+	total := 0
+	for _, p := range req.Prices {
+		if p.Label == "" {
+			return fmt.Errorf("%w: empty label", ErrInvalidPrice)
+		}
+		total += p.Amount
+	}
+	if total <= 0 {
+		return fmt.Errorf("%w: total amount must be positive", ErrInvalidPrice)
+	}
+
+	// limits are checked only for the known currencies
+	if _, ok := LookupCurrency(req.Currency); ok {
+		err := Money{Amount: total, Currency: req.Currency}.CheckLimits()
+		if err != nil {
+			return err
+		}
+	}
+
+	return validateTips(req.MaxTipAmount, req.SuggestedTipAmounts)
+}
+
+func validateTips(max int, suggested []int) error {
+	if max < 0 {
+		return fmt.Errorf("%w: negative max amount", ErrInvalidTips)
+	}
+	if len(suggested) > MaxSuggestedTips {
+		return fmt.Errorf("%w: at most %d suggested amounts", ErrInvalidTips, MaxSuggestedTips)
+	}
+
+	prev := 0
+	for _, v := range suggested {
+		if v <= prev {
+			return fmt.Errorf("%w: suggested amounts must be positive and increasing", ErrInvalidTips)
+		}
+		if v > max {
+			return fmt.Errorf("%w: suggested amount exceeds max amount", ErrInvalidTips)
+		}
+		prev = v
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package payments
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+var (
+	ErrUnknownCurrency = errors.New("unknown currency")
+	ErrInvalidAmount   = errors.New("invalid amount")
+	ErrAmountLimit     = errors.New("amount is out of the currency limits")
+)
+
+// Currency describes minor units of the currency and the limits of the
+// total amount, which are set by Telegram.
+type Currency struct {
+	Code string
+
+	// Exp is the count of digits after the decimal point, e.g. 2 for USD
+	// and 0 for JPY.
+	Exp int
+
+	// MinAmount and MaxAmount are in the smallest units, zero means no limit.
+	MinAmount int
+	MaxAmount int
+}
+
+var (
+	currenciesMu sync.RWMutex
+
+	// currencies is a snapshot of popular currencies from
+	// https://core.telegram.org/bots/payments/currencies.json. The limits are
+	// approximately US$1 and US$10000 and follow exchange rates, use
+	// LoadCurrencies to refresh them. Currencies with 3 digits after the
+	// decimal point are not covered, add them with LoadCurrencies or
+	// SetCurrency.
+	currencies = map[string]Currency{
+		"AED": {Code: "AED", Exp: 2, MinAmount: 367, MaxAmount: 3672940},
+		"ARS": {Code: "ARS", Exp: 2, MinAmount: 35000, MaxAmount: 350000000},
+		"AUD": {Code: "AUD", Exp: 2, MinAmount: 150, MaxAmount: 1500000},
+		"BRL": {Code: "BRL", Exp: 2, MinAmount: 500, MaxAmount: 5000000},
+		"CAD": {Code: "CAD", Exp: 2, MinAmount: 135, MaxAmount: 1350000},
+		"CHF": {Code: "CHF", Exp: 2, MinAmount: 90, MaxAmount: 900000},
+		"CLP": {Code: "CLP", Exp: 0, MinAmount: 900, MaxAmount: 9000000},
+		"CNY": {Code: "CNY", Exp: 2, MinAmount: 720, MaxAmount: 7200000},
+		"CZK": {Code: "CZK", Exp: 2, MinAmount: 2300, MaxAmount: 23000000},
+		"DKK": {Code: "DKK", Exp: 2, MinAmount: 690, MaxAmount: 6900000},
+		"EUR": {Code: "EUR", Exp: 2, MinAmount: 92, MaxAmount: 920000},
+		"GBP": {Code: "GBP", Exp: 2, MinAmount: 79, MaxAmount: 790000},
+		"HKD": {Code: "HKD", Exp: 2, MinAmount: 780, MaxAmount: 7800000},
+		"HUF": {Code: "HUF", Exp: 2, MinAmount: 36000, MaxAmount: 360000000},
+		"ILS": {Code: "ILS", Exp: 2, MinAmount: 370, MaxAmount: 3700000},
+		"INR": {Code: "INR", Exp: 2, MinAmount: 8300, MaxAmount: 83000000},
+		"ISK": {Code: "ISK", Exp: 0, MinAmount: 138, MaxAmount: 1380000},
+		"JPY": {Code: "JPY", Exp: 0, MinAmount: 150, MaxAmount: 1500000},
+		"KRW": {Code: "KRW", Exp: 0, MinAmount: 1350, MaxAmount: 13500000},
+		"KZT": {Code: "KZT", Exp: 2, MinAmount: 45000, MaxAmount: 450000000},
+		"MXN": {Code: "MXN", Exp: 2, MinAmount: 1700, MaxAmount: 17000000},
+		"NOK": {Code: "NOK", Exp: 2, MinAmount: 1050, MaxAmount: 10500000},
+		"NZD": {Code: "NZD", Exp: 2, MinAmount: 165, MaxAmount: 1650000},
+		"PLN": {Code: "PLN", Exp: 2, MinAmount: 400, MaxAmount: 4000000},
+		"RUB": {Code: "RUB", Exp: 2, MinAmount: 9000, MaxAmount: 90000000},
+		"SEK": {Code: "SEK", Exp: 2, MinAmount: 1050, MaxAmount: 10500000},
+		"SGD": {Code: "SGD", Exp: 2, MinAmount: 135, MaxAmount: 1350000},
+		"THB": {Code: "THB", Exp: 2, MinAmount: 3600, MaxAmount: 36000000},
+		"TRY": {Code: "TRY", Exp: 2, MinAmount: 3200, MaxAmount: 32000000},
+		"UAH": {Code: "UAH", Exp: 2, MinAmount: 4000, MaxAmount: 40000000},
+		"USD": {Code: "USD", Exp: 2, MinAmount: 100, MaxAmount: 1000000},
+		"VND": {Code: "VND", Exp: 0, MinAmount: 24000, MaxAmount: 240000000},
+		"ZAR": {Code: "ZAR", Exp: 2, MinAmount: 1850, MaxAmount: 18500000},
+	}
+)
+
+// LookupCurrency returns the currency by its ISO 4217 code.
+func LookupCurrency(code string) (Currency, bool) {
+	currenciesMu.RLock()
+	defer currenciesMu.RUnlock()
+
+	c, ok := currencies[code]
+	return c, ok
+}
+
+// SetCurrency adds or replaces the currency in the table.
+func SetCurrency(c Currency) {
+	currenciesMu.Lock()
+	defer currenciesMu.Unlock()
+
+	currencies[c.Code] = c
+}
+
+type currencyJSON struct {
+	Code      string `json:"code"`
+	Exp       int    `json:"exp"`
+	MinAmount string `json:"min_amount"`
+	MaxAmount string `json:"max_amount"`
+}
+
+// LoadCurrencies updates the table from currencies.json, published by
+// Telegram, to refresh the built-in limits.
+func LoadCurrencies(r io.Reader) error {
+	var data map[string]currencyJSON
+	err := json.NewDecoder(r).Decode(&data)
+	if err != nil {
+		return err
+	}
+
+	for code, v := range data {
+		c := Currency{Code: code, Exp: v.Exp}
+
+		c.MinAmount, err = strconv.Atoi(v.MinAmount)
+		if err != nil {
+			return fmt.Errorf("%s min_amount: %w", code, err)
+		}
+		c.MaxAmount, err = strconv.Atoi(v.MaxAmount)
+		if err != nil {
+			return fmt.Errorf("%s max_amount: %w", code, err)
+		}
+
+		SetCurrency(c)
+	}
+
+	return nil
+}
+
+// Money is an amount in the smallest units of the currency, like
+// LabeledPrice.Amount and SuccessfulPayment.TotalAmount.
+type Money struct {
+	Amount   int
+	Currency string
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseMoney parses decimal amount, like "12.50" USD or "1500" JPY.
+func ParseMoney(amount string, currency string) (Money, error) {
+	c, ok := LookupCurrency(currency)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %s", ErrUnknownCurrency, currency)
+	}
+
+	s := amount
+	negative := strings.HasPrefix(s, "-")
+	if negative {
+		s = s[1:]
+	}
+
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+
+	if !isDigits(whole) || (frac != "" && !isDigits(frac)) || len(frac) > c.Exp {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+
+	frac += strings.Repeat("0", c.Exp-len(frac))
+	v, err := strconv.Atoi(whole + frac)
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+
+	if negative {
+		v = -v
+	}
+	return Money{Amount: v, Currency: currency}, nil
+}
+
+// MustParseMoney is like ParseMoney, but panics on error.
+func MustParseMoney(amount string, currency string) Money {
+	m, err := ParseMoney(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Decimal returns the amount with the decimal point, e.g. "12.50". Unknown
+// currencies are formatted as integers.
+func (m Money) Decimal() string {
+	c, _ := LookupCurrency(m.Currency)
+
+	sign := ""
+	v := m.Amount
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+
+	s := strconv.Itoa(v)
+	if c.Exp == 0 {
+		return sign + s
+	}
+
+	if len(s) <= c.Exp {
+		s = strings.Repeat("0", c.Exp-len(s)+1) + s
+	}
+	return sign + s[:len(s)-c.Exp] + "." + s[len(s)-c.Exp:]
+}
+
+// String returns the amount with the currency code, e.g. "12.50 USD".
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+// Price returns the price portion for the invoice.
+func (m Money) Price(label string) telegram.LabeledPrice {
+	return telegram.LabeledPrice{Label: label, Amount: m.Amount}
+}
+
+// CheckLimits checks the total amount against the limits of the currency.
+func (m Money) CheckLimits() error {
+	c, ok := LookupCurrency(m.Currency)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownCurrency, m.Currency)
+	}
+
+	if (c.MinAmount != 0 && m.Amount < c.MinAmount) || (c.MaxAmount != 0 && m.Amount > c.MaxAmount) {
+		return fmt.Errorf("%w: %s", ErrAmountLimit, m)
+	}
+	return nil
+}
+
+// PaymentAmount returns the total amount of the payment.
+func PaymentAmount(p *telegram.SuccessfulPayment) Money {
+	return Money{Amount: p.TotalAmount, Currency: p.Currency}
+}
+
+// CheckoutAmount returns the total amount of the pre-checkout query.
+func CheckoutAmount(q *telegram.PreCheckoutQuery) Money {
+	return Money{Amount: q.TotalAmount, Currency: q.Currency}
+}
@@ -0,0 +1,93 @@
+package payments
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		amount   string
+		currency string
+		minor    int
+		decimal  string
+	}{
+		{"12.5", "USD", 1250, "12.50"},
+		{"0.05", "EUR", 5, "0.05"},
+		{"-3", "USD", -300, "-3.00"},
+		{"1500", "JPY", 1500, "1500"},
+	}
+
+	for _, test := range tests {
+		m, err := ParseMoney(test.amount, test.currency)
+		assert.Nil(t, err, test.amount)
+		assert.Equal(t, test.minor, m.Amount, test.amount)
+		assert.Equal(t, test.decimal, m.Decimal(), test.amount)
+	}
+
+	assert.Equal(t, "12.50 USD", MustParseMoney("12.5", "USD").String())
+
+	for _, amount := range []string{"", "1.234", "1.5e2", "--1", "+1", ".5", "1.-5"} {
+		_, err := ParseMoney(amount, "USD")
+		assert.True(t, errors.Is(err, ErrInvalidAmount), amount)
+	}
+
+	_, err := ParseMoney("1.5", "JPY")
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+
+	_, err = ParseMoney("1", "XXX")
+	assert.True(t, errors.Is(err, ErrUnknownCurrency))
+}
+
+// saveCurrencies returns function, which restores the currency table.
+func saveCurrencies() func() {
+	currenciesMu.Lock()
+	saved := make(map[string]Currency, len(currencies))
+	for k, v := range currencies {
+		saved[k] = v
+	}
+	currenciesMu.Unlock()
+
+	return func() {
+		currenciesMu.Lock()
+		currencies = saved
+		currenciesMu.Unlock()
+	}
+}
+
+func TestMoneyLimits(t *testing.T) {
+	defer saveCurrencies()()
+
+	// built-in limits
+	assert.Nil(t, MustParseMoney("1", "USD").CheckLimits())
+	assert.True(t, errors.Is(MustParseMoney("0.99", "USD").CheckLimits(), ErrAmountLimit))
+	assert.True(t, errors.Is(MustParseMoney("10000.01", "USD").CheckLimits(), ErrAmountLimit))
+
+	err := NewInvoice("Pizza", "Large pizza", "order-1", "USD").Price("Pizza", 50).Validate()
+	assert.True(t, errors.Is(err, ErrAmountLimit))
+
+	// loaded limits replace the built-in ones
+	err = LoadCurrencies(strings.NewReader(`{
+		"USD":{"code":"USD","exp":2,"min_amount":"50","max_amount":"2000000"},
+		"XTS":{"code":"XTS","exp":3,"min_amount":"1000","max_amount":"5000"}
+	}`))
+	assert.Nil(t, err)
+
+	assert.Nil(t, MustParseMoney("0.5", "USD").CheckLimits())
+	assert.Nil(t, MustParseMoney("10000.01", "USD").CheckLimits())
+	assert.True(t, errors.Is(MustParseMoney("0.49", "USD").CheckLimits(), ErrAmountLimit))
+	assert.Nil(t, NewInvoice("Pizza", "Large pizza", "order-1", "USD").Price("Pizza", 50).Validate())
+
+	m := MustParseMoney("4.5", "XTS")
+	assert.Equal(t, 4500, m.Amount)
+	assert.Nil(t, m.CheckLimits())
+	assert.Equal(t, telegram.LabeledPrice{Label: "Test", Amount: 4500}, m.Price("Test"))
+
+	p := PaymentAmount(&telegram.SuccessfulPayment{Currency: "XTS", TotalAmount: 7})
+	assert.Equal(t, "0.007 XTS", p.String())
+}
@@ -0,0 +1,186 @@
+package payments
+
+import (
+	"errors"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+// DefaultTimeout leaves time to answer before the 10 seconds deadline, after
+// which Telegram cancels the payment.
+const DefaultTimeout = 8 * time.Second
+
+// DefaultErrorMessage is shown to the user, when the query is declined
+// because of the handler error or timeout.
+const DefaultErrorMessage = "Sorry, the payment can't be processed now. Please try again later."
+
+// declineError is the reason shown to the user.
+type declineError struct {
+	message string
+}
+
+func (e *declineError) Error() string {
+	return e.message
+}
+
+// Decline returns error, which message is shown to the user. Other errors of
+// the handlers are logged and the user sees DefaultErrorMessage.
+func Decline(message string) error {
+	return &declineError{message: message}
+}
+
+// ShippingHandler returns shipping options available for the address.
+type ShippingHandler func(q *telegram.ShippingQuery) ([]telegram.ShippingOption, error)
+
+// PreCheckoutHandler confirms that the order can be fulfilled, e.g. that the
+// goods are still available.
+type PreCheckoutHandler func(q *telegram.PreCheckoutQuery) error
+
+// Payment is the event of the successful payment.
+type Payment struct {
+	Message *telegram.Message
+	*telegram.SuccessfulPayment
+}
+
+type PaymentHandler func(p *Payment)
+
+// Payments sends invoices and answers shipping and pre-checkout queries.
+type Payments struct {
+	bot           *telegram.Bot
+	providerToken string
+
+	// Timeout is the time given to the handlers, the query is declined
+	// automatically after it.
+	Timeout time.Duration
+
+	// OnShipping is required for invoices with flexible prices.
+	OnShipping ShippingHandler
+
+	// OnPreCheckout is optional, all orders are confirmed without it.
+	OnPreCheckout PreCheckoutHandler
+
+	OnPayment PaymentHandler
+}
+
+func New(bot *telegram.Bot, providerToken string) *Payments {
+	return &Payments{
+		bot:           bot,
+		providerToken: providerToken,
+		Timeout:       DefaultTimeout,
+	}
+}
+
+// SendInvoice validates the invoice and sends it to the chat.
+func (p *Payments) SendInvoice(chatID string, invoice *Invoice) (*telegram.Message, error) {
+	req, err := invoice.Request(chatID, p.providerToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.bot.SendInvoice(req)
+}
+
+// HandleUpdate handles shipping and pre-checkout queries and messages with
+// successful payment, other updates are ignored.
+func (p *Payments) HandleUpdate(update *telegram.Update) {
+	var err error
+	switch {
+	case update.ShippingQuery != nil:
+		err = p.answerShipping(update.ShippingQuery)
+	case update.PreCheckoutQuery != nil:
+		err = p.answerPreCheckout(update.PreCheckoutQuery)
+	case update.Message != nil && update.Message.SuccessfulPayment != nil:
+		if p.OnPayment != nil {
+			p.OnPayment(&Payment{
+				Message:           update.Message,
+				SuccessfulPayment: update.Message.SuccessfulPayment,
+			})
+		}
+	}
+
+	if err != nil {
+		log.WithError(err).WithField("update_id", update.UpdateID).Error("failed to answer payment query")
+	}
+}
+
+var (
+	errTimeout           = errors.New("payment handler timeout")
+	errNoShippingHandler = errors.New("shipping handler is not set")
+)
+
+// withTimeout runs f and returns its error or errTimeout.
+func (p *Payments) withTimeout(f func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- f()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(p.Timeout):
+		return errTimeout
+	}
+}
+
+// errorMessage returns message for the user and logs internal errors.
+func errorMessage(err error, queryID string) string {
+	var decline *declineError
+	if errors.As(err, &decline) {
+		return decline.message
+	}
+
+	log.WithError(err).WithField("query_id", queryID).Error("payment query is declined")
+	return DefaultErrorMessage
+}
+
+func (p *Payments) answerShipping(q *telegram.ShippingQuery) error {
+	var options []telegram.ShippingOption
+	err := errNoShippingHandler
+	if p.OnShipping != nil {
+		err = p.withTimeout(func() error {
+			res, err := p.OnShipping(q)
+			if err == nil {
+				options = res
+			}
+			return err
+		})
+	}
+
+	req := &telegram.AnswerShippingQueryRequest{
+		ShippingQueryID: q.ID,
+		Ok:              err == nil,
+	}
+	if err == nil {
+		// options are read only after the handler has finished
+		req.ShippingOptions = options
+	} else {
+		req.ErrorMessage = errorMessage(err, q.ID)
+	}
+
+	_, err = p.bot.AnswerShippingQuery(req)
+	return err
+}
+
+func (p *Payments) answerPreCheckout(q *telegram.PreCheckoutQuery) error {
+	var err error
+	if p.OnPreCheckout != nil {
+		err = p.withTimeout(func() error {
+			return p.OnPreCheckout(q)
+		})
+	}
+
+	req := &telegram.AnswerPreCheckoutQueryRequest{
+		PreCheckoutQueryID: q.ID,
+		Ok:                 err == nil,
+	}
+	if err != nil {
+		req.ErrorMessage = errorMessage(err, q.ID)
+	}
+
+	_, err = p.bot.AnswerPreCheckoutQuery(req)
+	return err
+}
@@ -0,0 +1,106 @@
+package payments
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/internal/telegramtest"
+)
+
+func TestInvoiceValidate(t *testing.T) {
+	valid := func() *Invoice {
+		return NewInvoice("Pizza", "Large pizza", "order-1", "USD").
+			Price("Pizza", 1500).
+			Price("Discount", -200)
+	}
+
+	assert.Nil(t, valid().Validate())
+	assert.Equal(t, 1300, valid().Total())
+
+	assert.Equal(t, ErrInvalidTitle, NewInvoice("", "d", "p", "USD").Price("a", 1).Validate())
+	assert.Equal(t, ErrInvalidPayload, NewInvoice("t", "d", "", "USD").Price("a", 1).Validate())
+	assert.Equal(t, ErrInvalidCurrency, NewInvoice("t", "d", "p", "usd").Price("a", 1).Validate())
+	assert.Equal(t, ErrNoPrices, NewInvoice("t", "d", "p", "USD").Validate())
+	assert.True(t, errors.Is(NewInvoice("t", "d", "p", "USD").Price("a", -1).Validate(), ErrInvalidPrice))
+
+	assert.Nil(t, valid().Tips(1000, 100, 200, 500).Validate())
+	assert.True(t, errors.Is(valid().Tips(1000, 200, 100).Validate(), ErrInvalidTips))
+	assert.True(t, errors.Is(valid().Tips(100, 200).Validate(), ErrInvalidTips))
+	assert.True(t, errors.Is(valid().Tips(1000, 1, 2, 3, 4, 5).Validate(), ErrInvalidTips))
+}
+
+func TestSendInvoice(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	p := New(rec.Bot(), "token")
+
+	_, err := p.SendInvoice("1", NewInvoice("Pizza", "Large pizza", "order-1", "USD").Price("Pizza", 1500).Shipping(true))
+	assert.Nil(t, err)
+
+	req := rec.Last().Req.(*telegram.SendInvoiceRequest)
+	assert.Equal(t, "1", req.ChatID)
+	assert.Equal(t, "token", req.ProviderToken)
+	assert.True(t, req.NeedShippingAddress)
+	assert.True(t, req.IsFlexible)
+}
+
+func TestQueries(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	p := New(rec.Bot(), "token")
+
+	// no handlers
+	p.HandleUpdate(&telegram.Update{PreCheckoutQuery: &telegram.PreCheckoutQuery{ID: "1"}})
+	assert.Equal(t, &telegram.AnswerPreCheckoutQueryRequest{PreCheckoutQueryID: "1", Ok: true}, rec.Last().Req)
+
+	p.HandleUpdate(&telegram.Update{ShippingQuery: &telegram.ShippingQuery{ID: "2"}})
+	assert.Equal(t, &telegram.AnswerShippingQueryRequest{ShippingQueryID: "2", ErrorMessage: DefaultErrorMessage}, rec.Last().Req)
+
+	options := []telegram.ShippingOption{{ID: "post", Title: "Post", Prices: []telegram.LabeledPrice{{Label: "Post", Amount: 300}}}}
+	p.OnShipping = func(q *telegram.ShippingQuery) ([]telegram.ShippingOption, error) {
+		if q.ShippingAddress.CountryCode != "US" {
+			return nil, Decline("We deliver only to the US")
+		}
+		return options, nil
+	}
+
+	p.HandleUpdate(&telegram.Update{ShippingQuery: &telegram.ShippingQuery{ID: "3", ShippingAddress: &telegram.ShippingAddress{CountryCode: "US"}}})
+	assert.Equal(t, &telegram.AnswerShippingQueryRequest{ShippingQueryID: "3", Ok: true, ShippingOptions: options}, rec.Last().Req)
+
+	p.HandleUpdate(&telegram.Update{ShippingQuery: &telegram.ShippingQuery{ID: "4", ShippingAddress: &telegram.ShippingAddress{CountryCode: "DE"}}})
+	assert.Equal(t, &telegram.AnswerShippingQueryRequest{ShippingQueryID: "4", ErrorMessage: "We deliver only to the US"}, rec.Last().Req)
+
+	p.Timeout = 10 * time.Millisecond
+	p.OnPreCheckout = func(q *telegram.PreCheckoutQuery) error {
+		time.Sleep(time.Second)
+		return nil
+	}
+
+	start := time.Now()
+	p.HandleUpdate(&telegram.Update{PreCheckoutQuery: &telegram.PreCheckoutQuery{ID: "5"}})
+	assert.True(t, time.Since(start) < time.Second)
+	assert.Equal(t, &telegram.AnswerPreCheckoutQueryRequest{PreCheckoutQueryID: "5", ErrorMessage: DefaultErrorMessage}, rec.Last().Req)
+}
+
+func TestPaymentEvent(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	p := New(rec.Bot(), "token")
+
+	var got *Payment
+	p.OnPayment = func(payment *Payment) {
+		got = payment
+	}
+
+	msg := &telegram.Message{
+		MessageID:         1,
+		SuccessfulPayment: &telegram.SuccessfulPayment{Currency: "USD", TotalAmount: 1800, InvoicePayload: "order-1"},
+	}
+	p.HandleUpdate(&telegram.Update{Message: msg})
+	p.HandleUpdate(&telegram.Update{Message: &telegram.Message{MessageID: 2}})
+
+	assert.Equal(t, msg, got.Message)
+	assert.Equal(t, "order-1", got.InvoicePayload)
+	assert.Equal(t, 1800, got.TotalAmount)
+}
@@ -145,15 +145,8 @@ type AnswerShippingQueryRequest struct {
 // is_flexible was specified, the Bot API will send an Update with a shipping_query
 // field to the bot. Use this method to reply to shipping queries. On success, True
 // is returned.
-func (b *Bot) AnswerShippingQuery(req *AnswerShippingQueryRequest) (*Update, error) {
-	j, err := b.makeRequest("answerShippingQuery", req)
-	if err != nil {
-		return nil, err
-	}
-
-	var resp Update
-	err = json.Unmarshal(j, &resp)
-	return &resp, err
+func (b *Bot) AnswerShippingQuery(req *AnswerShippingQueryRequest) (json.RawMessage, error) {
+	return b.makeRequest("answerShippingQuery", req)
 }
 
 type AnswerPreCheckoutQueryRequest struct {
@@ -177,15 +170,8 @@ type AnswerPreCheckoutQueryRequest struct {
 // pre_checkout_query. Use this method to respond to such pre-checkout queries. On
 // success, True is returned. Note: The Bot API must receive an answer within 10
 // seconds after the pre-checkout query was sent.
-func (b *Bot) AnswerPreCheckoutQuery(req *AnswerPreCheckoutQueryRequest) (*Update, error) {
-	j, err := b.makeRequest("answerPreCheckoutQuery", req)
-	if err != nil {
-		return nil, err
-	}
-
-	var resp Update
-	err = json.Unmarshal(j, &resp)
-	return &resp, err
+func (b *Bot) AnswerPreCheckoutQuery(req *AnswerPreCheckoutQueryRequest) (json.RawMessage, error) {
+	return b.makeRequest("answerPreCheckoutQuery", req)
 }
 
 // This object represents a portion of the price for goods or services.
@@ -3,9 +3,11 @@ package telegram
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/technoweenie/multipartstreamer"
 )
@@ -28,6 +30,17 @@ func (e ErrorResponse) Error() string {
 	return fmt.Sprintf("resp not ok, descr=%v, code=%v", e.Description, e.ErrorCode)
 }
 
+// IsMessageNotModified reports whether the edit request failed, because the
+// new content is the same as the current one. Such errors are usually ignored.
+func IsMessageNotModified(err error) bool {
+	var resp ErrorResponse
+	if !errors.As(err, &resp) {
+		return false
+	}
+
+	return resp.ErrorCode == 400 && strings.Contains(resp.Description, "message is not modified")
+}
+
 func (b *Bot) executeRequest(methodName string, req interface{}) (json.RawMessage, error) {
 	url := fmt.Sprintf("%s/bot%s/%s", requestAddress, b.token, methodName)
 
@@ -1,6 +1,8 @@
 package telegram
 
 import (
+	"encoding/json"
+	"fmt"
 	"io"
 	"testing"
 
@@ -68,3 +70,54 @@ func TestIsFileUpload(t *testing.T) {
 		err:       nil,
 	})
 }
+
+func TestIsFileUploadPointers(t *testing.T) {
+	type request struct {
+		Document   Fileable `json:"document"`
+		Type       *string  `json:"type,omitempty"`
+		IsPersonal *bool    `json:"is_personal,omitempty"`
+		CacheTime  *int     `json:"cache_time,omitempty"`
+	}
+
+	upload, ok := isFileUpload(&request{
+		Document:   mockUploader{},
+		Type:       String("quiz"),
+		IsPersonal: Bool(false),
+	})
+
+	assert.True(t, ok)
+	assert.Equal(t, map[string]string{
+		"type":        "quiz",
+		"is_personal": "false",
+	}, upload.params)
+}
+
+func TestOptionalPointers(t *testing.T) {
+	j, err := json.Marshal(ChatPermissions{
+		CanSendMessages: Bool(true),
+		CanSendPolls:    Bool(false),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"can_send_messages":true,"can_send_polls":false}`, string(j))
+
+	j, err = json.Marshal(SendPollRequest{
+		ChatID:          "1",
+		Question:        "?",
+		Options:         []string{"a", "b"},
+		CorrectOptionID: Int(0),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"chat_id":"1","question":"?","options":["a","b"],"correct_option_id":0}`, string(j))
+}
+
+func TestIsMessageNotModified(t *testing.T) {
+	err := ErrorResponse{
+		ErrorCode:   400,
+		Description: "Bad Request: message is not modified: specified new message content and reply markup are exactly the same as a current content and reply markup of the message",
+	}
+
+	assert.True(t, IsMessageNotModified(err))
+	assert.True(t, IsMessageNotModified(fmt.Errorf("edit: %w", err)))
+	assert.False(t, IsMessageNotModified(ErrorResponse{ErrorCode: 400, Description: "Bad Request: message to edit not found"}))
+	assert.False(t, IsMessageNotModified(nil))
+}
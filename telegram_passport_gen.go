@@ -134,35 +134,23 @@ func (b *Bot) SetPassportDataErrors(req *SetPassportDataErrorsRequest) (json.Raw
 // This object represents an error in the Telegram Passport element which was
 // submitted that should be resolved by the user. It should be one of:
 //
-//
-//
 // - PassportElementErrorDataField
 //
-//
 // - PassportElementErrorFrontSide
 //
-//
 // - PassportElementErrorReverseSide
 //
-//
 // - PassportElementErrorSelfie
 //
-//
 // - PassportElementErrorFile
 //
-//
 // - PassportElementErrorFiles
 //
-//
 // - PassportElementErrorTranslationFile
 //
-//
 // - PassportElementErrorTranslationFiles
 //
-//
 // - PassportElementErrorUnspecified
-//
-//
 type PassportElementError struct{}
 
 // Represents an issue in one of the data fields that was provided by the user. The
@@ -0,0 +1,217 @@
+package apigen
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+	"unicode"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// BuilderShortcut is an additional builder method, which sets the field.
+type BuilderShortcut struct {
+	Field string // field name, e.g. "parse_mode"
+	Name  string // method name, e.g. "HTML"
+	Value string // go expression to assign, method takes the value as an argument if empty
+}
+
+// ConstructorName returns name of the request constructor, e.g. "NewMessage" for sendMessage.
+func ConstructorName(method string) (string, error) {
+	name := method
+	if strings.HasPrefix(name, "send") && len(name) > len("send") {
+		name = strings.TrimPrefix(name, "send")
+	}
+
+	funcName, err := FuncNameToGo(name)
+	if err != nil {
+		return "", err
+	}
+
+	return "New" + funcName, nil
+}
+
+// ParamName converts go field name to a function parameter name, e.g. "ChatID" to "chatID".
+func ParamName(goName string) string {
+	runes := []rune(goName)
+
+	upper := 0
+	for upper < len(runes) && unicode.IsUpper(runes[upper]) {
+		upper++
+	}
+
+	switch {
+	case upper == len(runes):
+		// abbreviation, like URL
+		upper = len(runes)
+	case upper > 1:
+		// keep the first letter of the next word, like HTMLText -> htmlText
+		upper--
+	}
+
+	for i := 0; i < upper; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	name := string(runes)
+	if token.IsKeyword(name) {
+		name += "Value"
+	}
+
+	return name
+}
+
+func CodegenBuilder(obj *Object, f *jen.File, opts *GenOpts) error {
+	funcName, err := FuncNameToGo(obj.Name)
+	if err != nil {
+		return err
+	}
+
+	constructor, err := ConstructorName(obj.Name)
+	if err != nil {
+		return err
+	}
+
+	requestType := funcName + "Request"
+	fieldNames := make(map[string]bool)
+
+	var params []jen.Code
+	dict := jen.Dict{}
+
+	type setter struct {
+		field  Field
+		goName string
+		goType string
+	}
+	var setters []setter
+
+	for _, fld := range obj.Fields {
+		goName, err := FieldToGo(fld.Name)
+		if err != nil {
+			return err
+		}
+		fieldNames[goName] = true
+
+		goType, err := FieldTypeToGo(fld, obj.Name, opts)
+		if err != nil {
+			return fmt.Errorf("method %s, field %s: %w", obj.Name, fld.Name, err)
+		}
+
+		if fld.IsRequired {
+			param := ParamName(goName)
+			params = append(params, jen.Id(param).Id(goType))
+			dict[jen.Id(goName)] = jen.Id(param)
+			continue
+		}
+
+		setters = append(setters, setter{
+			field:  fld,
+			goName: goName,
+			goType: goType,
+		})
+	}
+
+	f.Comment(fmt.Sprintf("%s creates %s with the required parameters.", constructor, requestType))
+	f.Func().Id(constructor).Params(params...).Id("*" + requestType).Block(
+		jen.Return(jen.Op("&").Id(requestType).Values(dict)),
+	)
+	f.Line()
+
+	receiver := jen.Id("r").Id("*" + requestType)
+
+	for _, s := range setters {
+		field := jen.Id("r").Dot(s.goName)
+		value := jen.Id("v")
+		if opts.isPointer(obj.Name, s.field, s.goType) {
+			value = jen.Op("&").Id("v")
+		}
+
+		method := "With" + s.goName
+		f.Comment(fmt.Sprintf("%s sets optional parameter %s.", method, s.field.Name))
+		f.Func().Params(receiver.Clone()).Id(method).Params(jen.Id("v").Id(s.goType)).Id("*"+requestType).Block(
+			field.Op("=").Add(value),
+			jen.Return(jen.Id("r")),
+		)
+		f.Line()
+	}
+
+	for _, sc := range opts.BuilderShortcuts {
+		var s *setter
+		for i := range setters {
+			if setters[i].field.Name == sc.Field {
+				s = &setters[i]
+			}
+		}
+
+		if s == nil {
+			continue
+		}
+
+		if fieldNames[sc.Name] {
+			return fmt.Errorf("method %s: shortcut %s conflicts with the field", obj.Name, sc.Name)
+		}
+
+		field := jen.Id("r").Dot(s.goName)
+		isPointer := opts.isPointer(obj.Name, s.field, s.goType)
+
+		if sc.Value == "" {
+			value := jen.Id("v")
+			if isPointer {
+				value = jen.Op("&").Id("v")
+			}
+
+			f.Comment(fmt.Sprintf("%s sets optional parameter %s.", sc.Name, s.field.Name))
+			f.Func().Params(receiver.Clone()).Id(sc.Name).Params(jen.Id("v").Id(s.goType)).Id("*"+requestType).Block(
+				field.Op("=").Add(value),
+				jen.Return(jen.Id("r")),
+			)
+			f.Line()
+			continue
+		}
+
+		body := []jen.Code{field.Clone().Op("=").Id(sc.Value)}
+		if isPointer {
+			body = []jen.Code{
+				jen.Id("v").Op(":=").Id(sc.Value),
+				field.Clone().Op("=").Op("&").Id("v"),
+			}
+		}
+		body = append(body, jen.Return(jen.Id("r")))
+
+		f.Comment(fmt.Sprintf("%s sets %s to %s.", sc.Name, s.field.Name, sc.Value))
+		f.Func().Params(receiver.Clone()).Id(sc.Name).Params().Id("*" + requestType).Block(body...)
+		f.Line()
+	}
+
+	return nil
+}
+
+func CodegenBuilders(api *ParsedAPI, opts *GenOpts) (*jen.File, error) {
+	f := jen.NewFile(opts.PackageName)
+	constructors := make(map[string]string)
+
+	for _, chap := range sortedChapters(api) {
+		for _, obj := range chap.Objects {
+			if !obj.IsFunction || opts.skipMethod(obj.Name) {
+				continue
+			}
+
+			name, err := ConstructorName(obj.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			if prev, ok := constructors[name]; ok {
+				return nil, fmt.Errorf("constructor %s is generated for both %s and %s", name, prev, obj.Name)
+			}
+			constructors[name] = obj.Name
+
+			err = CodegenBuilder(obj, f, opts)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return f, nil
+}
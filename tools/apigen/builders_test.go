@@ -0,0 +1,28 @@
+package apigen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstructorName(t *testing.T) {
+	for method, expected := range map[string]string{
+		"sendMessage":         "NewMessage",
+		"sendMediaGroup":      "NewMediaGroup",
+		"getMe":               "NewGetMe",
+		"answerCallbackQuery": "NewAnswerCallbackQuery",
+	} {
+		name, err := ConstructorName(method)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, name)
+	}
+}
+
+func TestParamName(t *testing.T) {
+	assert.Equal(t, "chatID", ParamName("ChatID"))
+	assert.Equal(t, "url", ParamName("URL"))
+	assert.Equal(t, "htmlText", ParamName("HTMLText"))
+	assert.Equal(t, "text", ParamName("Text"))
+	assert.Equal(t, "typeValue", ParamName("Type"))
+}
@@ -3,6 +3,7 @@ package apigen
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"unicode"
 
@@ -16,6 +17,14 @@ type GenOpts struct {
 	TypeExceptions   []TypeException
 	MethodExceptions []MethodException
 	StructExceptions []StructException
+	Enums            []Enum
+
+	// OptionalPointers lists domains ("Object$field" prefixes) of optional scalar
+	// fields, which are generated as pointers, because their zero value is meaningful.
+	OptionalPointers []string
+
+	// BuilderShortcuts are additional builder methods for all requests with the field.
+	BuilderShortcuts []BuilderShortcut
 }
 
 type TypeException struct {
@@ -27,6 +36,7 @@ type TypeException struct {
 type MethodException struct {
 	Method       string
 	OverrideType string
+	Skip         bool
 }
 
 type StructException struct {
@@ -34,6 +44,26 @@ type StructException struct {
 	Skip       bool
 }
 
+func (o *GenOpts) skipStruct(typeName string) bool {
+	for _, ex := range o.StructExceptions {
+		if ex.StructName == typeName && ex.Skip {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (o *GenOpts) skipMethod(method string) bool {
+	for _, ex := range o.MethodExceptions {
+		if ex.Method == method && ex.Skip {
+			return true
+		}
+	}
+
+	return false
+}
+
 func ChapterNameToFilename(name string) string {
 	name = strings.ToLower(name)
 	name = strings.ReplaceAll(name, " ", "_")
@@ -116,28 +146,22 @@ func TypeToGo(t Type) (string, error) {
 		return "", fmt.Errorf("found whitespace in typename \"%s\"", t.Name)
 	}
 
-	var res string
-	if t.HasLink {
-		res += "*"
-	}
-	res += t.Name
-
 	if !t.HasLink {
-		log.Warn("Unrecognized simple type: ", t.Name)
+		return "", fmt.Errorf("unrecognized simple type \"%s\"", t.Name)
 	}
 
+	res := "*" + t.Name
+
 	res = fixURLSuffix(res)
 	return res, nil
 }
 
-func FieldToCode(f Field, objectName string, opts *GenOpts) (jen.Code, error) {
-	fieldName, err := FieldToGo(f.Name)
-	if err != nil {
-		return nil, err
+// FieldTypeToGo returns go type of the field, taking into account enums and type exceptions.
+func FieldTypeToGo(f Field, objectName string, opts *GenOpts) (string, error) {
+	if e := opts.enumFor(objectName, f); e != nil {
+		return e.Name, nil
 	}
 
-	var fieldType string
-	var typeException bool
 	for _, ex := range opts.TypeExceptions {
 		if ex.TypeString != f.Type.Name {
 			continue
@@ -145,19 +169,54 @@ func FieldToCode(f Field, objectName string, opts *GenOpts) (jen.Code, error) {
 
 		domain := fmt.Sprintf("%s$%s", objectName, f.Name)
 		if strings.HasPrefix(domain, ex.Domain) {
-			typeException = true
-			fieldType = ex.GoType
-			break
+			return ex.GoType, nil
 		}
 	}
 
-	if !typeException {
-		fieldType, err = TypeToGo(f.Type)
-		if err != nil {
-			return nil, err
+	return TypeToGo(f.Type)
+}
+
+// isScalar reports whether goType is a basic type or an enum.
+func (o *GenOpts) isScalar(goType string) bool {
+	switch goType {
+	case "string", "int", "float64", "bool":
+		return true
+	}
+
+	return o.isEnum(goType)
+}
+
+// isPointer reports whether optional field should be generated as a pointer.
+func (o *GenOpts) isPointer(objectName string, f Field, goType string) bool {
+	if !f.IsOptional || !o.isScalar(goType) {
+		return false
+	}
+
+	domain := fmt.Sprintf("%s$%s", objectName, f.Name)
+	for _, prefix := range o.OptionalPointers {
+		if strings.HasPrefix(domain, prefix) {
+			return true
 		}
 	}
 
+	return false
+}
+
+func FieldToCode(f Field, objectName string, opts *GenOpts) (jen.Code, error) {
+	fieldName, err := FieldToGo(f.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldType, err := FieldTypeToGo(f, objectName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.isPointer(objectName, f, fieldType) {
+		fieldType = "*" + fieldType
+	}
+
 	jsonTag := f.Name
 	if f.IsOptional {
 		jsonTag += ",omitempty"
@@ -196,7 +255,12 @@ func CodegenChapter(chap *Chapter, opts *GenOpts) (*jen.File, error) {
 			continue
 		}
 
-		log.Warn("Unknown object is ignored ", obj)
+		if opts.skipStruct(obj.Name) {
+			log.WithField("typeName", obj.Name).Info("skipping unknown object")
+			continue
+		}
+
+		return nil, fmt.Errorf("unknown object %s in chapter %s", obj.Name, chap.Name)
 	}
 
 	return f, nil
@@ -210,19 +274,12 @@ func CodegenStruct(obj *Object, f *jen.File, opts *GenOpts) error {
 		return err
 	}
 
-	for _, ex := range opts.StructExceptions {
-		if ex.StructName != typeName {
-			continue
-		}
-
-		if ex.Skip {
-			log.WithField("typeName", typeName).Info("skipping struct code generation")
-			return nil
-		}
+	if opts.skipStruct(typeName) {
+		log.WithField("typeName", typeName).Info("skipping struct code generation")
+		return nil
 	}
 
 	var fields []jen.Code
-	var skipType bool
 	for i, f := range obj.Fields {
 		if i != 0 {
 			fields = append(fields, jen.Line())
@@ -237,19 +294,12 @@ func CodegenStruct(obj *Object, f *jen.File, opts *GenOpts) error {
 
 		field, err := FieldToCode(f, name, opts)
 		if err != nil {
-			log.Warn("Error while processing type. ", err)
-			skipType = true
-			continue
+			return fmt.Errorf("struct %s, field %s: %w", typeName, f.Name, err)
 		}
 
 		fields = append(fields, field)
 	}
 
-	if skipType {
-		log.Warnf("Struct %s skipped!", typeName)
-		return nil
-	}
-
 	commentLines := processComments(obj.Notes)
 	for _, ln := range commentLines {
 		f.Comment(ln)
@@ -269,8 +319,12 @@ func CodegenFunc(obj *Object, f *jen.File, opts *GenOpts) error {
 		return err
 	}
 
+	if opts.skipMethod(name) {
+		log.WithField("method", name).Info("skipping method code generation")
+		return nil
+	}
+
 	var fields []jen.Code
-	var skipFunc bool
 	for i, f := range obj.Fields {
 		if i != 0 {
 			fields = append(fields, jen.Line())
@@ -289,19 +343,12 @@ func CodegenFunc(obj *Object, f *jen.File, opts *GenOpts) error {
 
 		field, err := FieldToCode(f, name, opts)
 		if err != nil {
-			log.Warn("Error while processing type. ", err)
-			skipFunc = true
-			continue
+			return fmt.Errorf("method %s, field %s: %w", name, f.Name, err)
 		}
 
 		fields = append(fields, field)
 	}
 
-	if skipFunc {
-		log.Warnf("Func %s skipped!", funcName)
-		return nil
-	}
-
 	requestType := funcName + "Request"
 
 	f.Type().Id(requestType).Struct(fields...)
@@ -364,6 +411,44 @@ func CodegenFunc(obj *Object, f *jen.File, opts *GenOpts) error {
 	return nil
 }
 
+// sortedChapters returns chapters sorted by name, to make generated code stable.
+func sortedChapters(api *ParsedAPI) []*Chapter {
+	res := make([]*Chapter, 0, len(api.Chapters))
+	for _, chap := range api.Chapters {
+		res = append(res, chap)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return res
+}
+
+func CodegenVersion(api *ParsedAPI, opts *GenOpts) (*jen.File, error) {
+	if api.Version == "" {
+		return nil, fmt.Errorf("unknown API version")
+	}
+
+	f := jen.NewFile(opts.PackageName)
+	f.Comment("BotAPIVersion is the version of Bot API, which was used to generate this package.")
+	f.Const().Id("BotAPIVersion").Op("=").Lit(api.Version)
+
+	return f, nil
+}
+
+func renderFile(f *jen.File, filename string, opts *GenOpts) error {
+	file, err := os.Create(opts.Dest + filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprint(file, "// Code generated by telegram-apigen. DO NOT EDIT.\n\n")
+
+	return f.Render(file)
+}
+
 func Codegen(api *ParsedAPI, opts *GenOpts) error {
 	for _, chap := range api.Chapters {
 		f, err := CodegenChapter(chap, opts)
@@ -371,19 +456,46 @@ func Codegen(api *ParsedAPI, opts *GenOpts) error {
 			return err
 		}
 
-		filename := ChapterNameToFilename(chap.Name)
-		file, err := os.Create(opts.Dest + filename)
+		err = renderFile(f, ChapterNameToFilename(chap.Name), opts)
 		if err != nil {
 			return err
 		}
+	}
+
+	f, err := CodegenVersion(api, opts)
+	if err != nil {
+		return err
+	}
 
-		fmt.Fprint(file, "// Code generated by telegram-apigen. DO NOT EDIT.\n\n")
+	err = renderFile(f, "version_gen.go", opts)
+	if err != nil {
+		return err
+	}
 
-		err = f.Render(file)
-		if err != nil {
-			return err
-		}
+	f, err = CodegenEnums(api, opts)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	err = renderFile(f, "enums_gen.go", opts)
+	if err != nil {
+		return err
+	}
+
+	f, err = CodegenBuilders(api, opts)
+	if err != nil {
+		return err
+	}
+
+	err = renderFile(f, "builders_gen.go", opts)
+	if err != nil {
+		return err
+	}
+
+	f, err = CodegenValidation(api, opts)
+	if err != nil {
+		return err
+	}
+
+	return renderFile(f, "validation_gen.go", opts)
 }
@@ -0,0 +1,136 @@
+package apigen
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type Range struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+// Constraints are limits of the field value, extracted from its description.
+type Constraints struct {
+	Length        *Range   `json:"length,omitempty"`         // string length in characters
+	Bytes         *Range   `json:"bytes,omitempty"`          // string length in bytes
+	AfterEntities bool     `json:"after_entities,omitempty"` // length is checked after entities parsing
+	Value         *Range   `json:"value,omitempty"`          // number value
+	Items         *Range   `json:"items,omitempty"`          // array length
+	ItemLength    *Range   `json:"item_length,omitempty"`    // length of each string in array
+	OneOf         []string `json:"one_of,omitempty"`         // allowed string values
+}
+
+var (
+	lengthRe     = regexp.MustCompile(`(\d+)-(\d+) characters`)
+	bytesRe      = regexp.MustCompile(`(\d+)-(\d+) bytes`)
+	itemsRe      = regexp.MustCompile(`(\d+)-(\d+) (?:items|strings)`)
+	itemLengthRe = regexp.MustCompile(`(\d+)-(\d+) characters each`)
+	valueRe      = regexp.MustCompile(`(?:between (\d+) and (\d+))|(?:(\d+)-(\d+))`)
+	quotedRe     = regexp.MustCompile(`“([^”]*)”`)
+)
+
+var oneOfTriggers = []string{
+	"one of ",
+	"can be either ",
+	"Can be either ",
+	"can be “",
+	"Can be “",
+}
+
+func matchRange(re *regexp.Regexp, s string) *Range {
+	m := re.FindStringSubmatch(s)
+	if m == nil {
+		return nil
+	}
+
+	// take the first matched pair of groups
+	for i := 1; i+1 < len(m); i += 2 {
+		if m[i] == "" {
+			continue
+		}
+
+		min, err := strconv.Atoi(m[i])
+		if err != nil {
+			return nil
+		}
+
+		max, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return nil
+		}
+
+		return &Range{Min: min, Max: max}
+	}
+
+	return nil
+}
+
+// quotedValues extracts “quoted” values from the sentence, which describes possible values.
+func quotedValues(descr string) []string {
+	pos := -1
+	for _, trigger := range oneOfTriggers {
+		if i := strings.Index(descr, trigger); i != -1 && (pos == -1 || i < pos) {
+			pos = i
+		}
+	}
+
+	if pos == -1 {
+		return nil
+	}
+
+	sentence := descr[pos:]
+	if end := strings.Index(sentence, ". "); end != -1 {
+		sentence = sentence[:end]
+	}
+
+	var values []string
+	for _, m := range quotedRe.FindAllStringSubmatch(sentence, -1) {
+		if m[1] == "" {
+			// some values are not representable as text
+			return nil
+		}
+
+		values = append(values, m[1])
+	}
+
+	return values
+}
+
+// ParseConstraints extracts constraints from the field description. em contains
+// emphasized words from the description, which are used as possible values when
+// description asks to choose one of them.
+func ParseConstraints(f Field, em []string) *Constraints {
+	var c Constraints
+	descr := f.Description
+
+	switch ParseTypeRef(f.Type.Name).Kind {
+	case KindString:
+		c.Length = matchRange(lengthRe, descr)
+		c.Bytes = matchRange(bytesRe, descr)
+		c.AfterEntities = c.Length != nil && strings.Contains(descr, "after entities parsing")
+
+		c.OneOf = quotedValues(descr)
+		if c.OneOf == nil && strings.Contains(descr, "Choose one") {
+			c.OneOf = em
+		}
+
+	case KindInteger, KindFloat:
+		c.Value = matchRange(valueRe, descr)
+
+	case KindArray:
+		c.Items = matchRange(itemsRe, descr)
+		c.ItemLength = matchRange(itemLengthRe, descr)
+	}
+
+	if c.isEmpty() {
+		return nil
+	}
+
+	return &c
+}
+
+func (c *Constraints) isEmpty() bool {
+	return c.Length == nil && c.Bytes == nil && c.Value == nil && c.Items == nil && c.ItemLength == nil && len(c.OneOf) == 0
+}
@@ -0,0 +1,28 @@
+package apigen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConstraints(t *testing.T) {
+	check := func(expected *Constraints, typeName string, descr string, em ...string) {
+		f := Field{
+			Type:        Type{Name: typeName},
+			Description: descr,
+		}
+		assert.Equal(t, expected, ParseConstraints(f, em))
+	}
+
+	check(&Constraints{Length: &Range{1, 4096}, AfterEntities: true}, "String", "Text of the message to be sent, 1-4096 characters after entities parsing")
+	check(&Constraints{Bytes: &Range{1, 128}}, "String", "Bot-defined invoice payload, 1-128 bytes. This will not be displayed to the user, use for your internal processes.")
+	check(&Constraints{Value: &Range{1, 100}}, "Integer", "Limits the number of photos to be retrieved. Values between 1-100 are accepted. Defaults to 100.")
+	check(&Constraints{Value: &Range{60, 86400}}, "Integer", "Period in seconds for which the location will be updated (see Live Locations, should be between 60 and 86400.")
+	check(&Constraints{Items: &Range{2, 10}, ItemLength: &Range{1, 100}}, "Array of String", "A JSON-serialized list of answer options, 2-10 strings 1-100 characters each")
+	check(&Constraints{OneOf: []string{"private", "group", "supergroup", "channel"}}, "String", "Type of chat, can be either “private”, “group”, “supergroup” or “channel”")
+	check(&Constraints{OneOf: []string{"image/jpeg", "image/gif", "video/mp4"}}, "String", "Optional. MIME type of the thumbnail, must be one of “image/jpeg”, “image/gif”, or “video/mp4”. Defaults to “image/jpeg”")
+	check(&Constraints{OneOf: []string{"typing", "upload_photo"}}, "String", "Type of action to broadcast. Choose one: typing for text messages, upload_photo for photos", "typing", "upload_photo")
+	check(nil, "String", "Unique identifier for the target chat")
+	check(nil, "String", "Currently, must be one of “”, “”")
+}
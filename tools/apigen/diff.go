@@ -0,0 +1,233 @@
+package apigen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type ChangeKind string
+
+const (
+	ChangeAdded   ChangeKind = "added"
+	ChangeRemoved ChangeKind = "removed"
+	ChangeChanged ChangeKind = "changed"
+)
+
+type Change struct {
+	Kind    ChangeKind
+	Object  string // "type Message" or "method sendMessage"
+	Field   string // empty for changes of the whole object
+	Details string
+}
+
+func (c Change) String() string {
+	var sign string
+	switch c.Kind {
+	case ChangeAdded:
+		sign = "+"
+	case ChangeRemoved:
+		sign = "-"
+	default:
+		sign = "~"
+	}
+
+	res := sign + " " + c.Object
+	if c.Field != "" {
+		res += ", field " + c.Field
+	}
+	if c.Details != "" {
+		res += ": " + c.Details
+	}
+
+	return res
+}
+
+// Changelog describes the difference between two parsed API versions.
+type Changelog struct {
+	OldVersion string
+	NewVersion string
+	Changes    []Change
+}
+
+func (c *Changelog) Empty() bool {
+	return len(c.Changes) == 0
+}
+
+func (c *Changelog) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Bot API %s -> %s\n", c.OldVersion, c.NewVersion)
+
+	if c.Empty() {
+		sb.WriteString("no changes\n")
+		return sb.String()
+	}
+
+	for _, ch := range c.Changes {
+		sb.WriteString(ch.String())
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+func objectKind(obj *Object) string {
+	switch {
+	case obj.IsType:
+		return "type"
+	case obj.IsFunction:
+		return "method"
+	default:
+		return "object"
+	}
+}
+
+func objectsByName(api *ParsedAPI) map[string]*Object {
+	res := make(map[string]*Object)
+	for _, chap := range api.Chapters {
+		for _, obj := range chap.Objects {
+			res[obj.Name] = obj
+		}
+	}
+
+	return res
+}
+
+func describeField(f Field) string {
+	if f.IsOptional {
+		return f.Type.Name + ", optional"
+	}
+
+	return f.Type.Name
+}
+
+func describeOptional(optional bool) string {
+	if optional {
+		return "optional"
+	}
+
+	return "required"
+}
+
+func diffFields(objName string, a, b []Field) []Change {
+	oldFields := make(map[string]Field)
+	for _, f := range a {
+		oldFields[f.Name] = f
+	}
+
+	newFields := make(map[string]bool)
+
+	var changes []Change
+	for _, f := range b {
+		newFields[f.Name] = true
+
+		prev, ok := oldFields[f.Name]
+		if !ok {
+			changes = append(changes, Change{
+				Kind:    ChangeAdded,
+				Object:  objName,
+				Field:   f.Name,
+				Details: describeField(f),
+			})
+			continue
+		}
+
+		if prev.Type.Name != f.Type.Name {
+			changes = append(changes, Change{
+				Kind:    ChangeChanged,
+				Object:  objName,
+				Field:   f.Name,
+				Details: fmt.Sprintf("type %s -> %s", prev.Type.Name, f.Type.Name),
+			})
+		}
+
+		if prev.IsOptional != f.IsOptional {
+			changes = append(changes, Change{
+				Kind:    ChangeChanged,
+				Object:  objName,
+				Field:   f.Name,
+				Details: fmt.Sprintf("%s -> %s", describeOptional(prev.IsOptional), describeOptional(f.IsOptional)),
+			})
+		}
+	}
+
+	for _, f := range a {
+		if !newFields[f.Name] {
+			changes = append(changes, Change{
+				Kind:   ChangeRemoved,
+				Object: objName,
+				Field:  f.Name,
+			})
+		}
+	}
+
+	return changes
+}
+
+// Diff compares types, methods and their fields of two parsed APIs, a is the
+// older version.
+func Diff(a, b *ParsedAPI) *Changelog {
+	oldObjects := objectsByName(a)
+	newObjects := objectsByName(b)
+
+	names := make(map[string]bool)
+	for name := range oldObjects {
+		names[name] = true
+	}
+	for name := range newObjects {
+		names[name] = true
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	res := &Changelog{
+		OldVersion: a.Version,
+		NewVersion: b.Version,
+	}
+
+	for _, name := range sorted {
+		prev, hasPrev := oldObjects[name]
+		cur, hasCur := newObjects[name]
+
+		switch {
+		case !hasPrev:
+			res.Changes = append(res.Changes, Change{
+				Kind:   ChangeAdded,
+				Object: objectKind(cur) + " " + name,
+			})
+
+		case !hasCur:
+			res.Changes = append(res.Changes, Change{
+				Kind:   ChangeRemoved,
+				Object: objectKind(prev) + " " + name,
+			})
+
+		default:
+			objName := objectKind(cur) + " " + name
+
+			if objectKind(prev) != objectKind(cur) {
+				res.Changes = append(res.Changes, Change{
+					Kind:    ChangeChanged,
+					Object:  objName,
+					Details: fmt.Sprintf("was %s", objectKind(prev)),
+				})
+			}
+
+			if prev.ReturnType != cur.ReturnType {
+				res.Changes = append(res.Changes, Change{
+					Kind:    ChangeChanged,
+					Object:  objName,
+					Details: fmt.Sprintf("return type %s -> %s", prev.ReturnType, cur.ReturnType),
+				})
+			}
+
+			res.Changes = append(res.Changes, diffFields(objName, prev.Fields, cur.Fields)...)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,69 @@
+package apigen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	old := &ParsedAPI{
+		Version: "5.1",
+		Chapters: map[string]*Chapter{
+			"Available types": {
+				Objects: []*Object{
+					{
+						IsType: true,
+						Name:   "Chat",
+						Fields: []Field{
+							{Name: "id", Type: Type{Name: "Integer"}},
+							{Name: "title", Type: Type{Name: "String"}, IsOptional: true},
+						},
+					},
+					{
+						IsType: true,
+						Name:   "Removed",
+					},
+				},
+			},
+		},
+	}
+
+	new := &ParsedAPI{
+		Version: "5.2",
+		Chapters: map[string]*Chapter{
+			"Available types": {
+				Objects: []*Object{
+					{
+						IsType: true,
+						Name:   "Chat",
+						Fields: []Field{
+							{Name: "id", Type: Type{Name: "Float"}},
+							{Name: "type", Type: Type{Name: "String"}},
+						},
+					},
+				},
+			},
+			"Available methods": {
+				Objects: []*Object{
+					{
+						IsFunction: true,
+						Name:       "getChat",
+						ReturnType: "Chat",
+					},
+				},
+			},
+		},
+	}
+
+	expected := `Bot API 5.1 -> 5.2
+~ type Chat, field id: type Integer -> Float
++ type Chat, field type: String
+- type Chat, field title
+- type Removed
++ method getChat
+`
+
+	assert.Equal(t, expected, Diff(old, new).String())
+	assert.True(t, Diff(new, new).Empty())
+}
@@ -0,0 +1,156 @@
+package apigen
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// Enum describes string type with a documented set of values. Values are
+// collected from descriptions of all matched fields.
+type Enum struct {
+	Name   string            // go type name
+	Fields []string          // "Object$field", or "$field" to match the field in any object
+	Values []string          // additional values, which are not listed in descriptions
+	Names  map[string]string // constant name suffixes for values, which can't be converted automatically
+}
+
+func (e *Enum) matches(objectName string, fieldName string) bool {
+	for _, v := range e.Fields {
+		if v == objectName+"$"+fieldName || v == "$"+fieldName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (o *GenOpts) enumFor(objectName string, f Field) *Enum {
+	if f.Type.Name != "String" {
+		return nil
+	}
+
+	for i := range o.Enums {
+		if o.Enums[i].matches(objectName, f.Name) {
+			return &o.Enums[i]
+		}
+	}
+
+	return nil
+}
+
+// isEnum reports whether goType is one of the generated enums.
+func (o *GenOpts) isEnum(goType string) bool {
+	for _, e := range o.Enums {
+		if e.Name == goType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *Enum) constName(value string) (string, error) {
+	suffix, ok := e.Names[value]
+	if !ok {
+		var err error
+		suffix, err = FieldToGo(value)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	name := e.Name + suffix
+	if !token.IsIdentifier(name) {
+		return "", fmt.Errorf("enum %s: can't make constant name for value \"%s\"", e.Name, value)
+	}
+
+	return name, nil
+}
+
+func CodegenEnum(api *ParsedAPI, e *Enum, f *jen.File) error {
+	values := append([]string(nil), e.Values...)
+	seen := make(map[string]bool)
+	for _, v := range values {
+		seen[v] = true
+	}
+
+	var fields []string
+	for _, chap := range sortedChapters(api) {
+		for _, obj := range chap.Objects {
+			for _, fld := range obj.Fields {
+				if fld.Type.Name != "String" || !e.matches(obj.Name, fld.Name) {
+					continue
+				}
+
+				fields = append(fields, obj.Name+"."+fld.Name)
+				if fld.Constraints == nil {
+					continue
+				}
+
+				for _, v := range fld.Constraints.OneOf {
+					if !seen[v] {
+						seen[v] = true
+						values = append(values, v)
+					}
+				}
+			}
+		}
+	}
+
+	if len(fields) == 0 {
+		return fmt.Errorf("enum %s doesn't match any field", e.Name)
+	}
+
+	if len(values) == 0 {
+		return fmt.Errorf("enum %s has no values", e.Name)
+	}
+
+	var consts []jen.Code
+	var names []jen.Code
+	for _, v := range values {
+		name, err := e.constName(v)
+		if err != nil {
+			return err
+		}
+
+		consts = append(consts, jen.Id(name).Id(e.Name).Op("=").Lit(v))
+		names = append(names, jen.Id(name))
+	}
+
+	fieldsList := strings.Join(fields, ", ")
+	if len(fields) > 3 {
+		fieldsList = strings.Join(fields[:3], ", ") + " and others"
+	}
+
+	f.Comment(fmt.Sprintf("%s is a set of documented values for %s.", e.Name, fieldsList))
+	f.Type().Id(e.Name).String()
+	f.Line()
+	f.Const().Defs(consts...)
+	f.Line()
+	f.Comment(fmt.Sprintf("IsValid reports whether the value is one of the known %s values.", e.Name))
+	f.Func().Params(jen.Id("v").Id(e.Name)).Id("IsValid").Params().Bool().Block(
+		jen.Switch(jen.Id("v")).Block(
+			jen.Case(names...).Block(jen.Return(jen.True())),
+		),
+		jen.Return(jen.False()),
+	)
+	f.Line()
+
+	return nil
+}
+
+func CodegenEnums(api *ParsedAPI, opts *GenOpts) (*jen.File, error) {
+	f := jen.NewFile(opts.PackageName)
+
+	for i := range opts.Enums {
+		err := CodegenEnum(api, &opts.Enums[i], f)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
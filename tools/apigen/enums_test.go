@@ -0,0 +1,36 @@
+package apigen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnum(t *testing.T) {
+	e := &Enum{
+		Name:   "ChatType",
+		Fields: []string{"Chat$type", "$chat_type"},
+		Names:  map[string]string{"🎲": "Dice"},
+	}
+
+	assert.True(t, e.matches("Chat", "type"))
+	assert.True(t, e.matches("InlineQuery", "chat_type"))
+	assert.False(t, e.matches("Poll", "type"))
+
+	name, err := e.constName("bot_command")
+	assert.Nil(t, err)
+	assert.Equal(t, "ChatTypeBotCommand", name)
+
+	name, err = e.constName("🎲")
+	assert.Nil(t, err)
+	assert.Equal(t, "ChatTypeDice", name)
+
+	_, err = e.constName("image/jpeg")
+	assert.NotNil(t, err)
+
+	opts := &GenOpts{Enums: []Enum{*e}}
+	goType, err := FieldTypeToGo(Field{Name: "type", Type: Type{Name: "String"}}, "Chat", opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "ChatType", goType)
+	assert.True(t, opts.isEnum(goType))
+}
@@ -9,6 +9,7 @@ import (
 )
 
 var DefaultParseOpts = &ParseOpts{
+	ChangesH3: "Recent changes",
 	IgnoreH3: []string{
 		"Recent changes",
 		"Authorizing your bot",
@@ -19,12 +20,14 @@ var DefaultParseOpts = &ParseOpts{
 		"Available types$Sending files",
 		"Available types$Inline mode objects",
 		"Available methods$Formatting options",
+		"Available methods$Inline mode methods",
 	},
 }
 
 type ParseOpts struct {
-	IgnoreH3 []string
-	IgnoreH4 []string
+	ChangesH3 string // section with the changelog, used to detect API version
+	IgnoreH3  []string
+	IgnoreH4  []string
 }
 
 func (o *ParseOpts) skipH3(h3 string) bool {
@@ -48,6 +51,7 @@ func (o *ParseOpts) skipH4(h3 string, h4 string) bool {
 }
 
 type ParsedAPI struct {
+	Version  string // e.g. "5.2"
 	Chapters map[string]*Chapter
 }
 
@@ -100,6 +104,7 @@ type Field struct {
 	Description string
 	IsOptional  bool
 	IsRequired  bool
+	Constraints *Constraints
 }
 
 type Type struct {
@@ -136,6 +141,15 @@ func extractText(n *html.Node) string {
 		return "\n"
 	}
 
+	if n.Type == html.ElementNode && n.Data == "img" {
+		// emoji are rendered as images
+		for _, attr := range n.Attr {
+			if attr.Key == "alt" {
+				return attr.Val
+			}
+		}
+	}
+
 	res := ""
 
 	if n.Type == html.ElementNode && n.Data == "li" {
@@ -160,6 +174,21 @@ func extractText(n *html.Node) string {
 	return res
 }
 
+// extractEm returns text of all <em> tags inside the node
+func extractEm(n *html.Node) []string {
+	var res []string
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if checkTag(c, "em") {
+			res = append(res, extractText(c))
+			continue
+		}
+
+		res = append(res, extractEm(c)...)
+	}
+
+	return res
+}
+
 func checkTag(n *html.Node, tag string) bool {
 	return n != nil && n.Type == html.ElementNode && n.Data == tag
 }
@@ -178,11 +207,13 @@ func parseRecursive1(ctx parseContext, n *html.Node) {
 func parsePageContent(ctx parseContext, n *html.Node) {
 	h3 := ""
 	h4 := ""
+	changes := false
 
 	for c := n.FirstChild; c != nil; c = c.NextSibling {
 		if c.Data == "h3" {
 			h3 = extractText(c)
 			h4 = ""
+			changes = ctx.opts.ChangesH3 != "" && h3 == ctx.opts.ChangesH3
 
 			if ctx.opts.skipH3(h3) {
 				h3 = ""
@@ -191,6 +222,10 @@ func parsePageContent(ctx parseContext, n *html.Node) {
 			continue
 		}
 
+		if changes && ctx.api.Version == "" && checkTag(c, "p") {
+			ctx.api.Version = parseVersion(c)
+		}
+
 		if h3 == "" {
 			// skip
 			continue
@@ -217,6 +252,24 @@ func parsePageContent(ctx parseContext, n *html.Node) {
 	}
 }
 
+// parseVersion looks for "<strong>Bot API X.Y</strong>" in the changelog paragraph
+func parseVersion(p *html.Node) string {
+	const prefix = "Bot API "
+
+	for c := p.FirstChild; c != nil; c = c.NextSibling {
+		if !checkTag(c, "strong") {
+			continue
+		}
+
+		text := strings.TrimSpace(extractText(c))
+		if strings.HasPrefix(text, prefix) {
+			return strings.TrimPrefix(text, prefix)
+		}
+	}
+
+	return ""
+}
+
 func parseObjectContext(ctx parseContext, obj *Object, c *html.Node) {
 	var addNote *html.Node
 
@@ -363,6 +416,8 @@ func parseTable(ctx parseContext, obj *Object, table *html.Node) {
 				ctx.shared.err = fmt.Errorf("unknown required info, %v", required)
 			}
 
+			field.Constraints = ParseConstraints(field, extractEm(td[3]))
+
 			obj.Fields = append(obj.Fields, field)
 		})
 
@@ -393,6 +448,7 @@ func parseTable(ctx parseContext, obj *Object, table *html.Node) {
 				IsOptional:  isOptional,
 				IsRequired:  !isOptional,
 			}
+			field.Constraints = ParseConstraints(field, extractEm(td[2]))
 
 			obj.Fields = append(obj.Fields, field)
 		})
@@ -434,5 +490,9 @@ func Parse(r io.Reader, opts *ParseOpts) (*ParsedAPI, error) {
 
 	parseRecursive1(rootCtx, doc)
 
+	if opts.ChangesH3 != "" && rootCtx.api.Version == "" && rootCtx.shared.err == nil {
+		rootCtx.shared.err = fmt.Errorf("failed to find API version in \"%s\"", opts.ChangesH3)
+	}
+
 	return rootCtx.api, rootCtx.shared.err
 }
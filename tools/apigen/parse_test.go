@@ -22,3 +22,20 @@ func TestExtractText(t *testing.T) {
 	check("FieldTypeDescription", `<thead><tr><th>Field</th><th>Type</th><th>Description</th></tr></thead>`)
 	check("- InputMediaAnimation\n- InputMediaDocument\n- InputMediaAudio\n- InputMediaPhoto\n- InputMediaVideo", `<ul><li><a href="#inputmediaanimation">InputMediaAnimation</a></li><li><a href="#inputmediadocument">InputMediaDocument</a></li><li><a href="#inputmediaaudio">InputMediaAudio</a></li><li><a href="#inputmediaphoto">InputMediaPhoto</a></li><li><a href="#inputmediavideo">InputMediaVideo</a></li></ul>`)
 }
+
+func TestParseVersion(t *testing.T) {
+	src := `<div id="dev_page_content">
+<h3>Recent changes</h3>
+<h4>April 26, 2021</h4>
+<p><strong>Bot API 5.2</strong></p>
+<h4>March 9, 2021</h4>
+<p><strong>Bot API 5.1</strong></p>
+</div>`
+
+	p, err := Parse(strings.NewReader(src), DefaultParseOpts)
+	assert.Nil(t, err)
+	assert.Equal(t, "5.2", p.Version)
+
+	_, err = Parse(strings.NewReader(`<div id="dev_page_content"></div>`), DefaultParseOpts)
+	assert.NotNil(t, err)
+}
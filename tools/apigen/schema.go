@@ -0,0 +1,289 @@
+package apigen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Schema is a machine-readable description of the parsed API. It contains
+// everything needed to generate code, so it can be used instead of html.
+type Schema struct {
+	Version  string          `json:"version"`
+	Chapters []SchemaChapter `json:"chapters"`
+	Types    []SchemaType    `json:"types"`
+	Methods  []SchemaMethod  `json:"methods"`
+}
+
+// SchemaChapter lists names of types and methods in order of appearance.
+type SchemaChapter struct {
+	Name    string   `json:"name"`
+	Objects []string `json:"objects"`
+}
+
+type SchemaType struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description,omitempty"`
+	Fields      []SchemaField `json:"fields,omitempty"`
+	OneOf       []string      `json:"one_of,omitempty"` // for types, which are a union of other types
+}
+
+type SchemaMethod struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description,omitempty"`
+	Params      []SchemaField `json:"params,omitempty"`
+	Returns     string        `json:"returns,omitempty"`
+}
+
+type SchemaField struct {
+	Name        string        `json:"name"`
+	Type        SchemaTypeRef `json:"type"`
+	Optional    bool          `json:"optional"`
+	Description string        `json:"description,omitempty"`
+	Constraints *Constraints  `json:"constraints,omitempty"`
+}
+
+const (
+	KindString  = "string"
+	KindInteger = "integer"
+	KindFloat   = "float"
+	KindBoolean = "boolean"
+	KindTrue    = "true"
+	KindObject  = "object"
+	KindArray   = "array"
+	KindUnion   = "union"
+)
+
+type SchemaTypeRef struct {
+	Raw   string          `json:"raw"` // type as written in the documentation
+	Kind  string          `json:"kind"`
+	Ref   string          `json:"ref,omitempty"`    // type name, for KindObject
+	Items *SchemaTypeRef  `json:"items,omitempty"`  // for KindArray
+	OneOf []SchemaTypeRef `json:"one_of,omitempty"` // for KindUnion
+}
+
+var unionSeparator = regexp.MustCompile(`, | or | and `)
+
+func ParseTypeRef(raw string) SchemaTypeRef {
+	const arrayPrefix = "Array of "
+
+	if strings.HasPrefix(raw, arrayPrefix) {
+		items := ParseTypeRef(strings.TrimPrefix(raw, arrayPrefix))
+		return SchemaTypeRef{
+			Raw:   raw,
+			Kind:  KindArray,
+			Items: &items,
+		}
+	}
+
+	if parts := unionSeparator.Split(raw, -1); len(parts) > 1 {
+		ref := SchemaTypeRef{
+			Raw:  raw,
+			Kind: KindUnion,
+		}
+		for _, part := range parts {
+			ref.OneOf = append(ref.OneOf, ParseTypeRef(part))
+		}
+		return ref
+	}
+
+	switch raw {
+	case "String":
+		return SchemaTypeRef{Raw: raw, Kind: KindString}
+	case "Integer":
+		return SchemaTypeRef{Raw: raw, Kind: KindInteger}
+	case "Float", "Float number":
+		return SchemaTypeRef{Raw: raw, Kind: KindFloat}
+	case "Boolean":
+		return SchemaTypeRef{Raw: raw, Kind: KindBoolean}
+	case "True":
+		return SchemaTypeRef{Raw: raw, Kind: KindTrue}
+	}
+
+	return SchemaTypeRef{Raw: raw, Kind: KindObject, Ref: raw}
+}
+
+// hasRef reports whether type references some object, like Type.HasLink.
+func (t SchemaTypeRef) hasRef() bool {
+	switch t.Kind {
+	case KindObject:
+		return true
+	case KindArray:
+		return t.Items.hasRef()
+	case KindUnion:
+		for _, v := range t.OneOf {
+			if v.hasRef() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func unionMembers(notes []string) []string {
+	var res []string
+	for _, note := range notes {
+		for _, ln := range strings.Split(note, "\n") {
+			ln = strings.TrimSpace(ln)
+			if strings.HasPrefix(ln, "- ") {
+				res = append(res, strings.TrimPrefix(ln, "- "))
+			}
+		}
+	}
+
+	return res
+}
+
+func exportFields(fields []Field) []SchemaField {
+	var res []SchemaField
+	for _, f := range fields {
+		res = append(res, SchemaField{
+			Name:        f.Name,
+			Type:        ParseTypeRef(f.Type.Name),
+			Optional:    f.IsOptional,
+			Description: f.Description,
+			Constraints: f.Constraints,
+		})
+	}
+
+	return res
+}
+
+// ExportSchema converts parsed API to schema. Chapters are sorted by name,
+// objects inside chapters preserve documentation order.
+func ExportSchema(api *ParsedAPI) *Schema {
+	s := &Schema{
+		Version: api.Version,
+	}
+
+	for _, chap := range sortedChapters(api) {
+		sc := SchemaChapter{
+			Name: chap.Name,
+		}
+
+		for _, obj := range chap.Objects {
+			sc.Objects = append(sc.Objects, obj.Name)
+			descr := strings.Join(obj.Notes, "\n\n")
+
+			if obj.IsFunction {
+				s.Methods = append(s.Methods, SchemaMethod{
+					Name:        obj.Name,
+					Description: descr,
+					Params:      exportFields(obj.Fields),
+					Returns:     obj.ReturnType,
+				})
+				continue
+			}
+
+			st := SchemaType{
+				Name:        obj.Name,
+				Description: descr,
+				Fields:      exportFields(obj.Fields),
+			}
+			if len(obj.Fields) == 0 {
+				st.OneOf = unionMembers(obj.Notes)
+			}
+			s.Types = append(s.Types, st)
+		}
+
+		s.Chapters = append(s.Chapters, sc)
+	}
+
+	return s
+}
+
+func importFields(fields []SchemaField) []Field {
+	var res []Field
+	for _, f := range fields {
+		res = append(res, Field{
+			Name: f.Name,
+			Type: Type{
+				Name:    f.Type.Raw,
+				HasLink: f.Type.hasRef(),
+			},
+			Description: f.Description,
+			IsOptional:  f.Optional,
+			IsRequired:  !f.Optional,
+			Constraints: f.Constraints,
+		})
+	}
+
+	return res
+}
+
+func importNotes(descr string) []string {
+	if descr == "" {
+		return nil
+	}
+
+	return []string{descr}
+}
+
+// ImportSchema converts schema back to parsed API, which can be used for Codegen.
+func ImportSchema(s *Schema) (*ParsedAPI, error) {
+	objects := make(map[string]*Object)
+
+	for _, t := range s.Types {
+		objects[t.Name] = &Object{
+			IsType: true,
+			Name:   t.Name,
+			Notes:  importNotes(t.Description),
+			Fields: importFields(t.Fields),
+		}
+	}
+
+	for _, m := range s.Methods {
+		if _, ok := objects[m.Name]; ok {
+			return nil, fmt.Errorf("duplicate object %s", m.Name)
+		}
+
+		objects[m.Name] = &Object{
+			IsFunction: true,
+			Name:       m.Name,
+			Notes:      importNotes(m.Description),
+			Fields:     importFields(m.Params),
+			ReturnType: m.Returns,
+		}
+	}
+
+	api := &ParsedAPI{
+		Version:  s.Version,
+		Chapters: make(map[string]*Chapter),
+	}
+
+	for _, sc := range s.Chapters {
+		chap := api.GetChapter(sc.Name)
+
+		for _, name := range sc.Objects {
+			obj, ok := objects[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown object %s in chapter %s", name, sc.Name)
+			}
+
+			chap.Objects = append(chap.Objects, obj)
+		}
+	}
+
+	return api, nil
+}
+
+func WriteSchema(w io.Writer, s *Schema) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+
+	return enc.Encode(s)
+}
+
+func ReadSchema(r io.Reader) (*Schema, error) {
+	var s Schema
+	err := json.NewDecoder(r).Decode(&s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
@@ -0,0 +1,65 @@
+package apigen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTypeRef(t *testing.T) {
+	assert.Equal(t, SchemaTypeRef{Raw: "Integer", Kind: KindInteger}, ParseTypeRef("Integer"))
+	assert.Equal(t, SchemaTypeRef{Raw: "Float number", Kind: KindFloat}, ParseTypeRef("Float number"))
+	assert.Equal(t, SchemaTypeRef{Raw: "Message", Kind: KindObject, Ref: "Message"}, ParseTypeRef("Message"))
+
+	assert.Equal(t, SchemaTypeRef{
+		Raw:  "Array of Array of PhotoSize",
+		Kind: KindArray,
+		Items: &SchemaTypeRef{
+			Raw:   "Array of PhotoSize",
+			Kind:  KindArray,
+			Items: &SchemaTypeRef{Raw: "PhotoSize", Kind: KindObject, Ref: "PhotoSize"},
+		},
+	}, ParseTypeRef("Array of Array of PhotoSize"))
+
+	assert.Equal(t, SchemaTypeRef{
+		Raw:  "Integer or String",
+		Kind: KindUnion,
+		OneOf: []SchemaTypeRef{
+			{Raw: "Integer", Kind: KindInteger},
+			{Raw: "String", Kind: KindString},
+		},
+	}, ParseTypeRef("Integer or String"))
+
+	union := ParseTypeRef("InputMediaAudio, InputMediaDocument, InputMediaPhoto and InputMediaVideo")
+	assert.Equal(t, KindUnion, union.Kind)
+	assert.Len(t, union.OneOf, 4)
+	assert.True(t, union.hasRef())
+	assert.False(t, ParseTypeRef("Integer or String").hasRef())
+}
+
+func TestExportSchema(t *testing.T) {
+	api := &ParsedAPI{
+		Version: "5.2",
+		Chapters: map[string]*Chapter{
+			"Available types": {
+				Name: "Available types",
+				Objects: []*Object{
+					{
+						IsType: true,
+						Name:   "InputMedia",
+						Notes:  []string{"It should be one of", "- InputMediaPhoto\n- InputMediaVideo"},
+					},
+				},
+			},
+		},
+	}
+
+	s := ExportSchema(api)
+	assert.Equal(t, []SchemaChapter{{Name: "Available types", Objects: []string{"InputMedia"}}}, s.Chapters)
+	assert.Equal(t, []string{"InputMediaPhoto", "InputMediaVideo"}, s.Types[0].OneOf)
+
+	imported, err := ImportSchema(s)
+	assert.Nil(t, err)
+	assert.Equal(t, "5.2", imported.Version)
+	assert.Equal(t, "InputMedia", imported.Chapters["Available types"].Objects[0].Name)
+}
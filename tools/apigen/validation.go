@@ -0,0 +1,224 @@
+package apigen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// isSetExpr returns expression, which is true if the field of goType has non-zero value.
+func isSetExpr(goType string, field *jen.Statement) *jen.Statement {
+	switch {
+	case goType == "string":
+		return field.Clone().Op("!=").Lit("")
+	case goType == "int" || goType == "float64":
+		return field.Clone().Op("!=").Lit(0)
+	case goType == "bool":
+		return field.Clone()
+	case strings.HasPrefix(goType, "[]"):
+		return jen.Len(field.Clone()).Op("!=").Lit(0)
+	default:
+		return field.Clone().Op("!=").Nil()
+	}
+}
+
+// canBeUnset reports whether zero value of goType means that the field is not set.
+func canBeUnset(goType string) bool {
+	switch goType {
+	case "int", "float64", "bool":
+		return false
+	}
+
+	return !strings.HasPrefix(goType, "[]")
+}
+
+func checkCall(fn string, args ...jen.Code) jen.Code {
+	return jen.If(
+		jen.Err().Op(":=").Id(fn).Call(args...),
+		jen.Err().Op("!=").Nil(),
+	).Block(
+		jen.Return(jen.Err()),
+	)
+}
+
+func hasField(obj *Object, name string) bool {
+	for _, f := range obj.Fields {
+		if f.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseModeField returns name of the field, which sets parse mode for the text field.
+func parseModeField(obj *Object, textField string) string {
+	if name := textField + "_parse_mode"; hasField(obj, name) {
+		return name
+	}
+
+	if hasField(obj, "parse_mode") {
+		return "parse_mode"
+	}
+
+	return ""
+}
+
+func constraintChecks(obj *Object, f Field, goType string, isEnum bool, field *jen.Statement) ([]jen.Code, error) {
+	c := f.Constraints
+	name := jen.Lit(f.Name)
+
+	var checks []jen.Code
+
+	if c.Length != nil && goType == "string" {
+		check := checkCall("validateLength", name, field.Clone(), jen.Lit(c.Length.Min), jen.Lit(c.Length.Max))
+
+		if pm := parseModeField(obj, f.Name); c.AfterEntities && pm != "" {
+			pmName, err := FieldToGo(pm)
+			if err != nil {
+				return nil, err
+			}
+
+			// length of the text with markup can't be checked before entities parsing
+			check = jen.If(jen.Id("r").Dot(pmName).Op("==").Lit("")).Block(check)
+
+			// but the text can't be empty in any parse mode
+			if c.Length.Min > 0 {
+				checks = append(checks, checkCall("validateRequired", name, isSetExpr(goType, field)))
+			}
+		}
+
+		checks = append(checks, check)
+	}
+
+	if c.Bytes != nil && goType == "string" {
+		checks = append(checks, checkCall("validateBytes", name, field.Clone(), jen.Lit(c.Bytes.Min), jen.Lit(c.Bytes.Max)))
+	}
+
+	if c.Value != nil {
+		switch goType {
+		case "int":
+			checks = append(checks, checkCall("validateIntRange", name, field.Clone(), jen.Lit(c.Value.Min), jen.Lit(c.Value.Max)))
+		case "float64":
+			checks = append(checks, checkCall("validateFloatRange", name, field.Clone(), jen.Lit(float64(c.Value.Min)), jen.Lit(float64(c.Value.Max))))
+		}
+	}
+
+	if c.Items != nil && strings.HasPrefix(goType, "[]") {
+		checks = append(checks, checkCall("validateItems", name, jen.Len(field.Clone()), jen.Lit(c.Items.Min), jen.Lit(c.Items.Max)))
+	}
+
+	if c.ItemLength != nil && goType == "[]string" {
+		checks = append(checks, jen.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Add(field.Clone())).Block(
+			checkCall("validateLength", name, jen.Id("v"), jen.Lit(c.ItemLength.Min), jen.Lit(c.ItemLength.Max)),
+		))
+	}
+
+	if len(c.OneOf) != 0 && isEnum {
+		checks = append(checks, checkCall("validateEnum", name, jen.String().Call(field.Clone()), field.Clone().Dot("IsValid").Call()))
+	} else if len(c.OneOf) != 0 && goType == "string" {
+		args := []jen.Code{name, field.Clone()}
+		for _, v := range c.OneOf {
+			args = append(args, jen.Lit(v))
+		}
+
+		checks = append(checks, checkCall("validateOneOf", args...))
+	}
+
+	return checks, nil
+}
+
+func CodegenValidate(obj *Object, f *jen.File, opts *GenOpts) error {
+	funcName, err := FuncNameToGo(obj.Name)
+	if err != nil {
+		return err
+	}
+
+	var body []jen.Code
+	for _, fld := range obj.Fields {
+		goName, err := FieldToGo(fld.Name)
+		if err != nil {
+			return err
+		}
+
+		goType, err := FieldTypeToGo(fld, obj.Name, opts)
+		if err != nil {
+			return fmt.Errorf("method %s, field %s: %w", obj.Name, fld.Name, err)
+		}
+
+		field := jen.Id("r").Dot(goName)
+		c := fld.Constraints
+
+		// checks of pointer fields use the pointed value
+		isPointer := opts.isPointer(obj.Name, fld, goType)
+		value := field
+		if isPointer {
+			value = jen.Parens(jen.Op("*").Add(field.Clone()))
+		}
+
+		isEnum := opts.isEnum(goType)
+		if isEnum {
+			goType = "string"
+		}
+
+		hasLength := c != nil && (c.Length != nil || c.Bytes != nil)
+		allowsEmpty := strings.Contains(fld.Description, "empty string")
+		if fld.IsRequired && !hasLength && !allowsEmpty && canBeUnset(goType) {
+			body = append(body, checkCall("validateRequired", jen.Lit(fld.Name), isSetExpr(goType, field)))
+		}
+
+		if c == nil {
+			continue
+		}
+
+		checks, err := constraintChecks(obj, fld, goType, isEnum, value)
+		if err != nil {
+			return err
+		}
+
+		if len(checks) == 0 {
+			continue
+		}
+
+		switch {
+		case isPointer:
+			body = append(body, jen.If(field.Clone().Op("!=").Nil()).Block(checks...))
+		case fld.IsOptional:
+			body = append(body, jen.If(isSetExpr(goType, field)).Block(checks...))
+		default:
+			body = append(body, checks...)
+		}
+	}
+
+	body = append(body, jen.Return(jen.Nil()))
+
+	requestType := funcName + "Request"
+
+	f.Comment("Validate checks the request against limits described in the documentation.")
+	f.Func().Params(
+		jen.Id("r").Id("*" + requestType),
+	).Id("Validate").Params().Error().Block(body...)
+	f.Line()
+
+	return nil
+}
+
+func CodegenValidation(api *ParsedAPI, opts *GenOpts) (*jen.File, error) {
+	f := jen.NewFile(opts.PackageName)
+
+	for _, chap := range sortedChapters(api) {
+		for _, obj := range chap.Objects {
+			if !obj.IsFunction || opts.skipMethod(obj.Name) {
+				continue
+			}
+
+			err := CodegenValidate(obj, f, opts)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return f, nil
+}
@@ -0,0 +1,295 @@
+package telegram
+
+// UpdateKind is the name of the optional field, which is set in the Update.
+// Values are the same as used in allowed_updates.
+type UpdateKind string
+
+const (
+	UpdateKindMessage            UpdateKind = "message"
+	UpdateKindEditedMessage      UpdateKind = "edited_message"
+	UpdateKindChannelPost        UpdateKind = "channel_post"
+	UpdateKindEditedChannelPost  UpdateKind = "edited_channel_post"
+	UpdateKindInlineQuery        UpdateKind = "inline_query"
+	UpdateKindChosenInlineResult UpdateKind = "chosen_inline_result"
+	UpdateKindCallbackQuery      UpdateKind = "callback_query"
+	UpdateKindShippingQuery      UpdateKind = "shipping_query"
+	UpdateKindPreCheckoutQuery   UpdateKind = "pre_checkout_query"
+	UpdateKindPoll               UpdateKind = "poll"
+	UpdateKindPollAnswer         UpdateKind = "poll_answer"
+	UpdateKindMyChatMember       UpdateKind = "my_chat_member"
+	UpdateKindChatMember         UpdateKind = "chat_member"
+)
+
+// AllUpdateKinds contains all known update kinds. Telegram doesn't send
+// chat_member updates, unless they're listed in allowed_updates explicitly.
+var AllUpdateKinds = []UpdateKind{
+	UpdateKindMessage,
+	UpdateKindEditedMessage,
+	UpdateKindChannelPost,
+	UpdateKindEditedChannelPost,
+	UpdateKindInlineQuery,
+	UpdateKindChosenInlineResult,
+	UpdateKindCallbackQuery,
+	UpdateKindShippingQuery,
+	UpdateKindPreCheckoutQuery,
+	UpdateKindPoll,
+	UpdateKindPollAnswer,
+	UpdateKindMyChatMember,
+	UpdateKindChatMember,
+}
+
+// AllowedUpdates returns value of allowed_updates for the kinds, duplicates
+// are removed and the order of AllUpdateKinds is kept.
+func AllowedUpdates(kinds ...UpdateKind) []string {
+	set := make(map[UpdateKind]bool)
+	for _, kind := range kinds {
+		set[kind] = true
+	}
+
+	res := []string{}
+	for _, kind := range AllUpdateKinds {
+		if set[kind] {
+			res = append(res, string(kind))
+		}
+	}
+	return res
+}
+
+// Kind returns kind of the update, empty for unknown updates.
+func (u *Update) Kind() UpdateKind {
+	switch {
+	case u.Message != nil:
+		return UpdateKindMessage
+	case u.EditedMessage != nil:
+		return UpdateKindEditedMessage
+	case u.ChannelPost != nil:
+		return UpdateKindChannelPost
+	case u.EditedChannelPost != nil:
+		return UpdateKindEditedChannelPost
+	case u.InlineQuery != nil:
+		return UpdateKindInlineQuery
+	case u.ChosenInlineResult != nil:
+		return UpdateKindChosenInlineResult
+	case u.CallbackQuery != nil:
+		return UpdateKindCallbackQuery
+	case u.ShippingQuery != nil:
+		return UpdateKindShippingQuery
+	case u.PreCheckoutQuery != nil:
+		return UpdateKindPreCheckoutQuery
+	case u.Poll != nil:
+		return UpdateKindPoll
+	case u.PollAnswer != nil:
+		return UpdateKindPollAnswer
+	case u.MyChatMember != nil:
+		return UpdateKindMyChatMember
+	case u.ChatMember != nil:
+		return UpdateKindChatMember
+	}
+	return ""
+}
+
+// EffectiveMessage returns the new or edited message, channel post or the
+// message with the pressed callback button. Returns nil for other updates.
+func (u *Update) EffectiveMessage() *Message {
+	switch {
+	case u.Message != nil:
+		return u.Message
+	case u.EditedMessage != nil:
+		return u.EditedMessage
+	case u.ChannelPost != nil:
+		return u.ChannelPost
+	case u.EditedChannelPost != nil:
+		return u.EditedChannelPost
+	case u.CallbackQuery != nil:
+		return u.CallbackQuery.Message
+	}
+	return nil
+}
+
+// EffectiveChat returns the chat, where the update happened. Returns nil for
+// inline queries, payments and polls.
+func (u *Update) EffectiveChat() *Chat {
+	if m := u.EffectiveMessage(); m != nil {
+		return m.Chat
+	}
+
+	switch {
+	case u.MyChatMember != nil:
+		return u.MyChatMember.Chat
+	case u.ChatMember != nil:
+		return u.ChatMember.Chat
+	}
+	return nil
+}
+
+// EffectiveUser returns the user, who caused the update. Returns nil for
+// channel posts, messages sent on behalf of chats and polls.
+func (u *Update) EffectiveUser() *User {
+	switch {
+	case u.Message != nil:
+		return u.Message.From
+	case u.EditedMessage != nil:
+		return u.EditedMessage.From
+	case u.ChannelPost != nil:
+		return u.ChannelPost.From
+	case u.EditedChannelPost != nil:
+		return u.EditedChannelPost.From
+	case u.InlineQuery != nil:
+		return u.InlineQuery.From
+	case u.ChosenInlineResult != nil:
+		return u.ChosenInlineResult.From
+	case u.CallbackQuery != nil:
+		return u.CallbackQuery.From
+	case u.ShippingQuery != nil:
+		return u.ShippingQuery.From
+	case u.PreCheckoutQuery != nil:
+		return u.PreCheckoutQuery.From
+	case u.PollAnswer != nil:
+		return u.PollAnswer.User
+	case u.MyChatMember != nil:
+		return u.MyChatMember.From
+	case u.ChatMember != nil:
+		return u.ChatMember.From
+	}
+	return nil
+}
+
+// MessageType is the kind of the message content.
+type MessageType string
+
+const (
+	MessageTypeText         MessageType = "text"
+	MessageTypeAnimation    MessageType = "animation"
+	MessageTypeAudio        MessageType = "audio"
+	MessageTypeDocument     MessageType = "document"
+	MessageTypePhoto        MessageType = "photo"
+	MessageTypeSticker      MessageType = "sticker"
+	MessageTypeVideo        MessageType = "video"
+	MessageTypeVideoNote    MessageType = "video_note"
+	MessageTypeVoice        MessageType = "voice"
+	MessageTypeContact      MessageType = "contact"
+	MessageTypeDice         MessageType = "dice"
+	MessageTypeGame         MessageType = "game"
+	MessageTypePoll         MessageType = "poll"
+	MessageTypeVenue        MessageType = "venue"
+	MessageTypeLocation     MessageType = "location"
+	MessageTypeInvoice      MessageType = "invoice"
+	MessageTypePassportData MessageType = "passport_data"
+
+	// service messages
+	MessageTypeNewChatMembers                MessageType = "new_chat_members"
+	MessageTypeLeftChatMember                MessageType = "left_chat_member"
+	MessageTypeNewChatTitle                  MessageType = "new_chat_title"
+	MessageTypeNewChatPhoto                  MessageType = "new_chat_photo"
+	MessageTypeDeleteChatPhoto               MessageType = "delete_chat_photo"
+	MessageTypeGroupChatCreated              MessageType = "group_chat_created"
+	MessageTypeSupergroupChatCreated         MessageType = "supergroup_chat_created"
+	MessageTypeChannelChatCreated            MessageType = "channel_chat_created"
+	MessageTypeMessageAutoDeleteTimerChanged MessageType = "message_auto_delete_timer_changed"
+	MessageTypeMigrateToChatID               MessageType = "migrate_to_chat_id"
+	MessageTypeMigrateFromChatID             MessageType = "migrate_from_chat_id"
+	MessageTypePinnedMessage                 MessageType = "pinned_message"
+	MessageTypeSuccessfulPayment             MessageType = "successful_payment"
+	MessageTypeConnectedWebsite              MessageType = "connected_website"
+	MessageTypeProximityAlertTriggered       MessageType = "proximity_alert_triggered"
+	MessageTypeVoiceChatScheduled            MessageType = "voice_chat_scheduled"
+	MessageTypeVoiceChatStarted              MessageType = "voice_chat_started"
+	MessageTypeVoiceChatEnded                MessageType = "voice_chat_ended"
+	MessageTypeVoiceChatParticipantsInvited  MessageType = "voice_chat_participants_invited"
+)
+
+// Type returns kind of the message content, empty for unknown messages.
+// Messages with animation also have the document set, and venues have the
+// location set, the more specific type is returned.
+func (m *Message) Type() MessageType {
+	switch {
+	case m.Text != "":
+		return MessageTypeText
+	case m.Animation != nil:
+		return MessageTypeAnimation
+	case m.Audio != nil:
+		return MessageTypeAudio
+	case m.Document != nil:
+		return MessageTypeDocument
+	case len(m.Photo) != 0:
+		return MessageTypePhoto
+	case m.Sticker != nil:
+		return MessageTypeSticker
+	case m.Video != nil:
+		return MessageTypeVideo
+	case m.VideoNote != nil:
+		return MessageTypeVideoNote
+	case m.Voice != nil:
+		return MessageTypeVoice
+	case m.Contact != nil:
+		return MessageTypeContact
+	case m.Dice != nil:
+		return MessageTypeDice
+	case m.Game != nil:
+		return MessageTypeGame
+	case m.Poll != nil:
+		return MessageTypePoll
+	case m.Venue != nil:
+		return MessageTypeVenue
+	case m.Location != nil:
+		return MessageTypeLocation
+	case m.Invoice != nil:
+		return MessageTypeInvoice
+	case m.PassportData != nil:
+		return MessageTypePassportData
+
+	case len(m.NewChatMembers) != 0:
+		return MessageTypeNewChatMembers
+	case m.LeftChatMember != nil:
+		return MessageTypeLeftChatMember
+	case m.NewChatTitle != "":
+		return MessageTypeNewChatTitle
+	case len(m.NewChatPhoto) != 0:
+		return MessageTypeNewChatPhoto
+	case m.DeleteChatPhoto:
+		return MessageTypeDeleteChatPhoto
+	case m.GroupChatCreated:
+		return MessageTypeGroupChatCreated
+	case m.SupergroupChatCreated:
+		return MessageTypeSupergroupChatCreated
+	case m.ChannelChatCreated:
+		return MessageTypeChannelChatCreated
+	case m.MessageAutoDeleteTimerChanged != nil:
+		return MessageTypeMessageAutoDeleteTimerChanged
+	case m.MigrateToChatID != 0:
+		return MessageTypeMigrateToChatID
+	case m.MigrateFromChatID != 0:
+		return MessageTypeMigrateFromChatID
+	case m.PinnedMessage != nil:
+		return MessageTypePinnedMessage
+	case m.SuccessfulPayment != nil:
+		return MessageTypeSuccessfulPayment
+	case m.ConnectedWebsite != "":
+		return MessageTypeConnectedWebsite
+	case m.ProximityAlertTriggered != nil:
+		return MessageTypeProximityAlertTriggered
+	case m.VoiceChatScheduled != nil:
+		return MessageTypeVoiceChatScheduled
+	case m.VoiceChatStarted != nil:
+		return MessageTypeVoiceChatStarted
+	case m.VoiceChatEnded != nil:
+		return MessageTypeVoiceChatEnded
+	case m.VoiceChatParticipantsInvited != nil:
+		return MessageTypeVoiceChatParticipantsInvited
+	}
+	return ""
+}
+
+// IsService reports whether the message is a service message, like new chat
+// members or pinned message.
+func (m *Message) IsService() bool {
+	switch m.Type() {
+	case "", MessageTypeText, MessageTypeAnimation, MessageTypeAudio, MessageTypeDocument,
+		MessageTypePhoto, MessageTypeSticker, MessageTypeVideo, MessageTypeVideoNote,
+		MessageTypeVoice, MessageTypeContact, MessageTypeDice, MessageTypeGame,
+		MessageTypePoll, MessageTypeVenue, MessageTypeLocation, MessageTypeInvoice,
+		MessageTypePassportData:
+		return false
+	}
+	return true
+}
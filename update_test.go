@@ -0,0 +1,62 @@
+package telegram
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateAccessors(t *testing.T) {
+	user := &User{ID: 1}
+	chat := &Chat{ID: 2}
+	msg := &Message{MessageID: 3, From: user, Chat: chat}
+
+	u := &Update{CallbackQuery: &CallbackQuery{From: user, Message: msg}}
+	assert.Equal(t, UpdateKindCallbackQuery, u.Kind())
+	assert.Equal(t, msg, u.EffectiveMessage())
+	assert.Equal(t, chat, u.EffectiveChat())
+	assert.Equal(t, user, u.EffectiveUser())
+
+	u = &Update{ChannelPost: &Message{Chat: chat}}
+	assert.Equal(t, UpdateKindChannelPost, u.Kind())
+	assert.Equal(t, chat, u.EffectiveChat())
+	assert.Nil(t, u.EffectiveUser())
+
+	u = &Update{InlineQuery: &InlineQuery{From: user}}
+	assert.Nil(t, u.EffectiveMessage())
+	assert.Nil(t, u.EffectiveChat())
+	assert.Equal(t, user, u.EffectiveUser())
+
+	u = &Update{MyChatMember: &ChatMemberUpdated{Chat: chat, From: user}}
+	assert.Equal(t, UpdateKindMyChatMember, u.Kind())
+	assert.Equal(t, chat, u.EffectiveChat())
+	assert.Equal(t, user, u.EffectiveUser())
+
+	assert.Equal(t, UpdateKind(""), (&Update{UpdateID: 1}).Kind())
+}
+
+func TestMessageType(t *testing.T) {
+	tests := []struct {
+		json    string
+		typ     MessageType
+		service bool
+	}{
+		{`{"text":"hi"}`, MessageTypeText, false},
+		{`{"photo":[{"file_id":"a"}],"caption":"hi"}`, MessageTypePhoto, false},
+		{`{"animation":{"file_id":"a"},"document":{"file_id":"a"}}`, MessageTypeAnimation, false},
+		{`{"venue":{"title":"a"},"location":{"latitude":1}}`, MessageTypeVenue, false},
+		{`{"sticker":{"file_id":"a"}}`, MessageTypeSticker, false},
+		{`{"new_chat_members":[{"id":1}]}`, MessageTypeNewChatMembers, true},
+		{`{"pinned_message":{"text":"hi"}}`, MessageTypePinnedMessage, true},
+		{`{"group_chat_created":true}`, MessageTypeGroupChatCreated, true},
+		{`{}`, "", false},
+	}
+
+	for _, test := range tests {
+		var m Message
+		assert.Nil(t, json.Unmarshal([]byte(test.json), &m))
+		assert.Equal(t, test.typ, m.Type(), test.json)
+		assert.Equal(t, test.service, m.IsService(), test.json)
+	}
+}
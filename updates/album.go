@@ -0,0 +1,147 @@
+package updates
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+// DefaultAlbumWindow is the time to wait for the next message of the album.
+const DefaultAlbumWindow = time.Second
+
+// Album is a media group, sent as several messages.
+type Album struct {
+	// Kind is message or channel_post.
+	Kind         telegram.UpdateKind
+	MediaGroupID string
+
+	// Messages sorted by MessageID.
+	Messages []*telegram.Message
+}
+
+// Caption returns caption of the album, which is set to one of the messages.
+func (a *Album) Caption() string {
+	for _, m := range a.Messages {
+		if m.Caption != "" {
+			return m.Caption
+		}
+	}
+	return ""
+}
+
+type AlbumHandler func(album *Album)
+
+type albumBuffer struct {
+	album *Album
+	timer *time.Timer
+}
+
+// Albums buffers messages with the same MediaGroupID and delivers them to
+// the album handler at once, other updates are passed to the next handler.
+// HandleUpdate can be used for both StartPolling and webhook updates.
+type Albums struct {
+	next    Handler
+	onAlbum AlbumHandler
+
+	// Window is the time after the last message of the album, when the album
+	// is considered complete.
+	Window time.Duration
+
+	mu     sync.Mutex
+	groups map[string]*albumBuffer
+}
+
+func NewAlbums(onAlbum AlbumHandler, next Handler) *Albums {
+	return &Albums{
+		next:    next,
+		onAlbum: onAlbum,
+		Window:  DefaultAlbumWindow,
+		groups:  make(map[string]*albumBuffer),
+	}
+}
+
+// AlbumMiddleware returns middleware, which handles albums with onAlbum.
+func AlbumMiddleware(window time.Duration, onAlbum AlbumHandler) func(next Handler) Handler {
+	return func(next Handler) Handler {
+		a := NewAlbums(onAlbum, next)
+		a.Window = window
+		return a.HandleUpdate
+	}
+}
+
+// HandleUpdate buffers the message of the album or passes the update to
+// the next handler.
+func (a *Albums) HandleUpdate(update *telegram.Update) {
+	kind := update.Kind()
+	m := update.EffectiveMessage()
+
+	if (kind != telegram.UpdateKindMessage && kind != telegram.UpdateKindChannelPost) || m.MediaGroupID == "" {
+		if a.next != nil {
+			a.next(update)
+		}
+		return
+	}
+
+	key := m.MediaGroupID
+	if m.Chat != nil {
+		key = strconv.Itoa(m.Chat.ID) + "_" + key
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	buf, ok := a.groups[key]
+	if !ok {
+		buf = &albumBuffer{
+			album: &Album{
+				Kind:         kind,
+				MediaGroupID: m.MediaGroupID,
+			},
+		}
+		buf.timer = time.AfterFunc(a.Window, func() {
+			a.deliver(key, buf)
+		})
+		a.groups[key] = buf
+	} else {
+		buf.timer.Reset(a.Window)
+	}
+
+	buf.album.Messages = append(buf.album.Messages, m)
+}
+
+// deliver removes the album from the buffer and calls the handler, if the
+// album wasn't delivered yet.
+func (a *Albums) deliver(key string, buf *albumBuffer) {
+	a.mu.Lock()
+	if a.groups[key] != buf {
+		a.mu.Unlock()
+		return
+	}
+	delete(a.groups, key)
+	a.mu.Unlock()
+
+	messages := buf.album.Messages
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].MessageID < messages[j].MessageID
+	})
+
+	a.onAlbum(buf.album)
+}
+
+// Flush delivers all buffered albums without waiting, e.g. before shutdown.
+func (a *Albums) Flush() {
+	a.mu.Lock()
+	groups := make(map[string]*albumBuffer, len(a.groups))
+	for key, buf := range a.groups {
+		buf.timer.Stop()
+		groups[key] = buf
+	}
+	a.mu.Unlock()
+
+	for key, buf := range groups {
+		a.deliver(key, buf)
+	}
+}
@@ -0,0 +1,87 @@
+package updates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+func albumMessage(id int, group string, caption string) *telegram.Update {
+	return &telegram.Update{
+		UpdateID: id,
+		Message: &telegram.Message{
+			MessageID:    id,
+			Chat:         &telegram.Chat{ID: 1},
+			MediaGroupID: group,
+			Caption:      caption,
+		},
+	}
+}
+
+func messageIDs(album *Album) []int {
+	var ids []int
+	for _, m := range album.Messages {
+		ids = append(ids, m.MessageID)
+	}
+	return ids
+}
+
+func TestAlbums(t *testing.T) {
+	albums := make(chan *Album, 10)
+	var updates []int
+
+	handler := AlbumMiddleware(20*time.Millisecond, func(album *Album) {
+		albums <- album
+	})(func(update *telegram.Update) {
+		updates = append(updates, update.UpdateID)
+	})
+
+	handler(albumMessage(2, "a", ""))
+	handler(albumMessage(1, "a", "caption"))
+	handler(albumMessage(3, "", ""))
+	handler(albumMessage(4, "b", ""))
+	handler(&telegram.Update{UpdateID: 5, EditedMessage: albumMessage(5, "a", "").Message})
+
+	assert.Equal(t, []int{3, 5}, updates)
+
+	var got []*Album
+	for i := 0; i < 2; i++ {
+		select {
+		case album := <-albums:
+			got = append(got, album)
+		case <-time.After(time.Second):
+			t.Fatal("album is not delivered")
+		}
+	}
+
+	if got[0].MediaGroupID != "a" {
+		got[0], got[1] = got[1], got[0]
+	}
+
+	assert.Equal(t, []int{1, 2}, messageIDs(got[0]))
+	assert.Equal(t, "caption", got[0].Caption())
+	assert.Equal(t, telegram.UpdateKindMessage, got[0].Kind)
+	assert.Equal(t, []int{4}, messageIDs(got[1]))
+}
+
+func TestAlbumsFlush(t *testing.T) {
+	var got []*Album
+	a := NewAlbums(func(album *Album) {
+		got = append(got, album)
+	}, nil)
+	a.Window = time.Hour
+
+	a.HandleUpdate(albumMessage(1, "a", ""))
+	a.HandleUpdate(albumMessage(2, "a", ""))
+	a.HandleUpdate(albumMessage(3, "", ""))
+	a.Flush()
+
+	assert.Len(t, got, 1)
+	assert.Equal(t, []int{1, 2}, messageIDs(got[0]))
+
+	a.Flush()
+	assert.Len(t, got, 1)
+}
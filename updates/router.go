@@ -0,0 +1,142 @@
+package updates
+
+import (
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/callback"
+)
+
+type Handler func(update *telegram.Update)
+
+type CallbackHandler func(query *telegram.CallbackQuery)
+
+// Router dispatches updates to the registered handlers. Callback queries
+// are dispatched by the prefix of the data, see callback.Prefix, other
+// updates go to the handlers of their kind and to the generic handlers.
+type Router struct {
+	mu        sync.RWMutex
+	storage   *callback.Storage
+	callbacks map[string]CallbackHandler
+	kinds     map[telegram.UpdateKind][]Handler
+	handlers  []Handler
+	allowed   []telegram.UpdateKind
+}
+
+func NewRouter() *Router {
+	return &Router{
+		callbacks: make(map[string]CallbackHandler),
+		kinds:     make(map[telegram.UpdateKind][]Handler),
+	}
+}
+
+// SetStorage enables resolution of callback data, saved to the storage,
+// before the dispatch.
+func (r *Router) SetStorage(s *callback.Storage) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.storage = s
+}
+
+// Handle adds handler for all updates, which are not handled by callback handlers.
+func (r *Router) Handle(h Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.handlers = append(r.handlers, h)
+}
+
+// HandleKind adds handler for updates of the kind.
+func (r *Router) HandleKind(kind telegram.UpdateKind, h Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.kinds[kind] = append(r.kinds[kind], h)
+}
+
+// Allow adds kinds to AllowedUpdates, which are handled outside of the router.
+func (r *Router) Allow(kinds ...telegram.UpdateKind) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.allowed = append(r.allowed, kinds...)
+}
+
+// AllowedUpdates returns kinds of the updates, which are handled by the
+// router. Pass it to GetUpdatesRequest.AllowedUpdates or Opts.AllowedUpdates,
+// so that only these updates are received. Generic handlers require all
+// updates, including chat_member.
+func (r *Router) AllowedUpdates() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.handlers) != 0 {
+		return telegram.AllowedUpdates(telegram.AllUpdateKinds...)
+	}
+
+	kinds := append([]telegram.UpdateKind{}, r.allowed...)
+	for kind, handlers := range r.kinds {
+		if len(handlers) != 0 {
+			kinds = append(kinds, kind)
+		}
+	}
+	if len(r.callbacks) != 0 {
+		kinds = append(kinds, telegram.UpdateKindCallbackQuery)
+	}
+
+	return telegram.AllowedUpdates(kinds...)
+}
+
+// HandleCallback sets handler for callback queries with the data prefix.
+func (r *Router) HandleCallback(prefix string, h CallbackHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.callbacks[prefix] = h
+}
+
+// HandleUpdate dispatches the update, can be used as Opts.HandleUpdate.
+func (r *Router) HandleUpdate(update *telegram.Update) {
+	r.mu.RLock()
+	storage := r.storage
+	handlers := r.handlers
+	kindHandlers := r.kinds[update.Kind()]
+	r.mu.RUnlock()
+
+	if q := update.CallbackQuery; q != nil {
+		if storage != nil {
+			err := storage.ResolveQuery(q)
+			if err != nil {
+				log.WithError(err).WithField("data", q.Data).Warn("failed to resolve callback data")
+			}
+		}
+
+		r.mu.RLock()
+		h, ok := r.callbacks[callback.Prefix(q.Data)]
+		r.mu.RUnlock()
+
+		if ok {
+			h(q)
+			return
+		}
+	}
+
+	for _, h := range kindHandlers {
+		h(update)
+	}
+
+	for _, h := range handlers {
+		h(update)
+	}
+}
+
+// Listen handles updates from the channel, returned by StartPolling, until it's closed.
+func (r *Router) Listen(ch <-chan telegram.Update) {
+	for update := range ch {
+		update := update
+		r.HandleUpdate(&update)
+	}
+}
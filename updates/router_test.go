@@ -0,0 +1,57 @@
+package updates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/callback"
+)
+
+func TestRouter(t *testing.T) {
+	r := NewRouter()
+
+	var callbacks []string
+	var updates []int
+	r.HandleCallback("page", func(q *telegram.CallbackQuery) {
+		callbacks = append(callbacks, q.Data)
+	})
+	r.Handle(func(update *telegram.Update) {
+		updates = append(updates, update.UpdateID)
+	})
+
+	storage := callback.NewStorage(callback.NewMemoryStore(10), time.Hour)
+	r.SetStorage(storage)
+	key, err := storage.Data("page:" + string(make([]byte, 100)))
+	assert.Nil(t, err)
+
+	r.HandleUpdate(&telegram.Update{UpdateID: 1, CallbackQuery: &telegram.CallbackQuery{Data: "page:1"}})
+	r.HandleUpdate(&telegram.Update{UpdateID: 2, CallbackQuery: &telegram.CallbackQuery{Data: "other:1"}})
+	r.HandleUpdate(&telegram.Update{UpdateID: 3, Message: &telegram.Message{}})
+	r.HandleUpdate(&telegram.Update{UpdateID: 4, CallbackQuery: &telegram.CallbackQuery{Data: key}})
+
+	assert.Equal(t, []string{"page:1", "page:" + string(make([]byte, 100))}, callbacks)
+	assert.Equal(t, []int{2, 3}, updates)
+}
+
+func TestRouterAllowedUpdates(t *testing.T) {
+	r := NewRouter()
+	assert.Equal(t, []string{}, r.AllowedUpdates())
+
+	var members []int
+	r.HandleKind(telegram.UpdateKindChatMember, func(update *telegram.Update) {
+		members = append(members, update.UpdateID)
+	})
+	r.HandleCallback("page", func(q *telegram.CallbackQuery) {})
+	r.Allow(telegram.UpdateKindMessage, telegram.UpdateKindCallbackQuery)
+	assert.Equal(t, []string{"message", "callback_query", "chat_member"}, r.AllowedUpdates())
+
+	r.HandleUpdate(&telegram.Update{UpdateID: 1, ChatMember: &telegram.ChatMemberUpdated{}})
+	r.HandleUpdate(&telegram.Update{UpdateID: 2, Message: &telegram.Message{}})
+	assert.Equal(t, []int{1}, members)
+
+	r.Handle(func(update *telegram.Update) {})
+	assert.Len(t, r.AllowedUpdates(), len(telegram.AllUpdateKinds))
+}
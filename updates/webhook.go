@@ -25,6 +25,9 @@ type Opts struct {
 	Timeout      int
 	Port         string // ":80"
 	HandleUpdate func(update *telegram.Update)
+
+	// AllowedUpdates is passed to SetWebhook, see Router.AllowedUpdates.
+	AllowedUpdates []string
 }
 
 type Webhook struct {
@@ -59,7 +62,8 @@ func NewWebhook(bot *telegram.Bot, opts *Opts) (*Webhook, error) {
 	addrWH.Path = path.Join(addrWH.Path, wh.botHash)
 
 	_, err = bot.SetWebhook(&telegram.SetWebhookRequest{
-		URL: addrWH.String(),
+		URL:            addrWH.String(),
+		AllowedUpdates: opts.AllowedUpdates,
 	})
 	if err != nil {
 		return nil, err
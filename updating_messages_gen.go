@@ -23,7 +23,7 @@ type EditMessageTextRequest struct {
 
 	// Optional. Mode for parsing entities in the message text. See formatting options
 	// for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in message text, which can be
 	// specified instead of parse_mode
@@ -69,7 +69,7 @@ type EditMessageCaptionRequest struct {
 
 	// Optional. Mode for parsing entities in the message caption. See formatting
 	// options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption, which can be
 	// specified instead of parse_mode
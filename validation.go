@@ -0,0 +1,95 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Validator is implemented by all generated requests.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError is returned when request doesn't satisfy limits of Bot API.
+type ValidationError struct {
+	Field  string
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+}
+
+// ValidationMiddleware rejects invalid requests before sending them to telegram.
+// Can be used as Opts.Middleware.
+func ValidationMiddleware(next RequestHandler) RequestHandler {
+	return func(methodName string, req interface{}) (json.RawMessage, error) {
+		if v, ok := req.(Validator); ok {
+			if err := v.Validate(); err != nil {
+				return nil, fmt.Errorf("%s: %w", methodName, err)
+			}
+		}
+
+		return next(methodName, req)
+	}
+}
+
+func validateRequired(field string, isSet bool) error {
+	if !isSet {
+		return &ValidationError{Field: field, Reason: "required field is empty"}
+	}
+	return nil
+}
+
+func validateLength(field string, value string, min, max int) error {
+	if n := UTF16Len(value); n < min || n > max {
+		return &ValidationError{Field: field, Reason: fmt.Sprintf("length %d is out of range %d-%d characters", n, min, max)}
+	}
+	return nil
+}
+
+func validateBytes(field string, value string, min, max int) error {
+	if n := len(value); n < min || n > max {
+		return &ValidationError{Field: field, Reason: fmt.Sprintf("length %d is out of range %d-%d bytes", n, min, max)}
+	}
+	return nil
+}
+
+func validateIntRange(field string, value int, min, max int) error {
+	if value < min || value > max {
+		return &ValidationError{Field: field, Reason: fmt.Sprintf("value %d is out of range %d-%d", value, min, max)}
+	}
+	return nil
+}
+
+func validateFloatRange(field string, value float64, min, max float64) error {
+	if value < min || value > max {
+		return &ValidationError{Field: field, Reason: fmt.Sprintf("value %v is out of range %v-%v", value, min, max)}
+	}
+	return nil
+}
+
+func validateItems(field string, count int, min, max int) error {
+	if count < min || count > max {
+		return &ValidationError{Field: field, Reason: fmt.Sprintf("%d items is out of range %d-%d", count, min, max)}
+	}
+	return nil
+}
+
+func validateOneOf(field string, value string, values ...string) error {
+	for _, v := range values {
+		if v == value {
+			return nil
+		}
+	}
+
+	return &ValidationError{Field: field, Reason: fmt.Sprintf("%q is not one of %s", value, strings.Join(values, ", "))}
+}
+
+func validateEnum(field string, value string, valid bool) error {
+	if !valid {
+		return &ValidationError{Field: field, Reason: fmt.Sprintf("unknown value %q", value)}
+	}
+	return nil
+}
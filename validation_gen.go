@@ -0,0 +1,833 @@
+// Code generated by telegram-apigen. DO NOT EDIT.
+
+package telegram
+
+// Validate checks the request against limits described in the documentation.
+func (r *GetMeRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *LogOutRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *CloseRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendMessageRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("text", r.Text != ""); err != nil {
+		return err
+	}
+	if r.ParseMode == "" {
+		if err := validateLength("text", r.Text, 1, 4096); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *ForwardMessageRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("from_chat_id", r.FromChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *CopyMessageRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("from_chat_id", r.FromChatID != ""); err != nil {
+		return err
+	}
+	if r.Caption != "" {
+		if r.ParseMode == "" {
+			if err := validateLength("caption", r.Caption, 0, 1024); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendPhotoRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("photo", r.Photo != nil); err != nil {
+		return err
+	}
+	if r.Caption != "" {
+		if r.ParseMode == "" {
+			if err := validateLength("caption", r.Caption, 0, 1024); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendAudioRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("audio", r.Audio != nil); err != nil {
+		return err
+	}
+	if r.Caption != "" {
+		if r.ParseMode == "" {
+			if err := validateLength("caption", r.Caption, 0, 1024); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendDocumentRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("document", r.Document != nil); err != nil {
+		return err
+	}
+	if r.Caption != "" {
+		if r.ParseMode == "" {
+			if err := validateLength("caption", r.Caption, 0, 1024); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendVideoRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("video", r.Video != nil); err != nil {
+		return err
+	}
+	if r.Caption != "" {
+		if r.ParseMode == "" {
+			if err := validateLength("caption", r.Caption, 0, 1024); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendAnimationRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("animation", r.Animation != nil); err != nil {
+		return err
+	}
+	if r.Caption != "" {
+		if r.ParseMode == "" {
+			if err := validateLength("caption", r.Caption, 0, 1024); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendVoiceRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("voice", r.Voice != nil); err != nil {
+		return err
+	}
+	if r.Caption != "" {
+		if r.ParseMode == "" {
+			if err := validateLength("caption", r.Caption, 0, 1024); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendVideoNoteRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("video_note", r.VideoNote != nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendLocationRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if r.HorizontalAccuracy != 0 {
+		if err := validateFloatRange("horizontal_accuracy", r.HorizontalAccuracy, 0.0, 1500.0); err != nil {
+			return err
+		}
+	}
+	if r.LivePeriod != 0 {
+		if err := validateIntRange("live_period", r.LivePeriod, 60, 86400); err != nil {
+			return err
+		}
+	}
+	if r.Heading != 0 {
+		if err := validateIntRange("heading", r.Heading, 1, 360); err != nil {
+			return err
+		}
+	}
+	if r.ProximityAlertRadius != 0 {
+		if err := validateIntRange("proximity_alert_radius", r.ProximityAlertRadius, 1, 100000); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *EditMessageLiveLocationRequest) Validate() error {
+	if r.HorizontalAccuracy != 0 {
+		if err := validateFloatRange("horizontal_accuracy", r.HorizontalAccuracy, 0.0, 1500.0); err != nil {
+			return err
+		}
+	}
+	if r.Heading != 0 {
+		if err := validateIntRange("heading", r.Heading, 1, 360); err != nil {
+			return err
+		}
+	}
+	if r.ProximityAlertRadius != 0 {
+		if err := validateIntRange("proximity_alert_radius", r.ProximityAlertRadius, 1, 100000); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *StopMessageLiveLocationRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendVenueRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("title", r.Title != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("address", r.Address != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendContactRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("phone_number", r.PhoneNumber != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("first_name", r.FirstName != ""); err != nil {
+		return err
+	}
+	if r.Vcard != "" {
+		if err := validateBytes("vcard", r.Vcard, 0, 2048); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendPollRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateLength("question", r.Question, 1, 300); err != nil {
+		return err
+	}
+	if err := validateItems("options", len(r.Options), 2, 10); err != nil {
+		return err
+	}
+	for _, v := range r.Options {
+		if err := validateLength("options", v, 1, 100); err != nil {
+			return err
+		}
+	}
+	if r.Explanation != "" {
+		if r.ExplanationParseMode == "" {
+			if err := validateLength("explanation", r.Explanation, 0, 200); err != nil {
+				return err
+			}
+		}
+	}
+	if r.OpenPeriod != 0 {
+		if err := validateIntRange("open_period", r.OpenPeriod, 5, 600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendDiceRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if r.Emoji != "" {
+		if err := validateEnum("emoji", string(r.Emoji), r.Emoji.IsValid()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendChatActionRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("action", r.Action != ""); err != nil {
+		return err
+	}
+	if err := validateEnum("action", string(r.Action), r.Action.IsValid()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *GetUserProfilePhotosRequest) Validate() error {
+	if r.Limit != 0 {
+		if err := validateIntRange("limit", r.Limit, 1, 100); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *GetFileRequest) Validate() error {
+	if err := validateRequired("file_id", r.FileID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *KickChatMemberRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *UnbanChatMemberRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *RestrictChatMemberRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("permissions", r.Permissions != nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *PromoteChatMemberRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SetChatAdministratorCustomTitleRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateLength("custom_title", r.CustomTitle, 0, 16); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SetChatPermissionsRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("permissions", r.Permissions != nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *ExportChatInviteLinkRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *CreateChatInviteLinkRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if r.MemberLimit != 0 {
+		if err := validateIntRange("member_limit", r.MemberLimit, 1, 99999); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *EditChatInviteLinkRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("invite_link", r.InviteLink != ""); err != nil {
+		return err
+	}
+	if r.MemberLimit != 0 {
+		if err := validateIntRange("member_limit", r.MemberLimit, 1, 99999); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *RevokeChatInviteLinkRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("invite_link", r.InviteLink != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SetChatPhotoRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("photo", r.Photo != nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *DeleteChatPhotoRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SetChatTitleRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateLength("title", r.Title, 1, 255); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SetChatDescriptionRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if r.Description != "" {
+		if err := validateLength("description", r.Description, 0, 255); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *PinChatMessageRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *UnpinChatMessageRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *UnpinAllChatMessagesRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *LeaveChatRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *GetChatRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *GetChatAdministratorsRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *GetChatMembersCountRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *GetChatMemberRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SetChatStickerSetRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("sticker_set_name", r.StickerSetName != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *DeleteChatStickerSetRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *AnswerCallbackQueryRequest) Validate() error {
+	if err := validateRequired("callback_query_id", r.CallbackQueryID != ""); err != nil {
+		return err
+	}
+	if r.Text != "" {
+		if err := validateLength("text", r.Text, 0, 200); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SetMyCommandsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *GetMyCommandsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendGameRequest) Validate() error {
+	if err := validateRequired("game_short_name", r.GameShortName != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SetGameScoreRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *GetGameHighScoresRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *GetUpdatesRequest) Validate() error {
+	if r.Limit != 0 {
+		if err := validateIntRange("limit", r.Limit, 1, 100); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SetWebhookRequest) Validate() error {
+	if r.MaxConnections != 0 {
+		if err := validateIntRange("max_connections", r.MaxConnections, 1, 100); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *DeleteWebhookRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *GetWebhookInfoRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *AnswerInlineQueryRequest) Validate() error {
+	if err := validateRequired("inline_query_id", r.InlineQueryID != ""); err != nil {
+		return err
+	}
+	if r.SwitchPmParameter != "" {
+		if err := validateLength("switch_pm_parameter", r.SwitchPmParameter, 1, 64); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendInvoiceRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateLength("title", r.Title, 1, 32); err != nil {
+		return err
+	}
+	if err := validateLength("description", r.Description, 1, 255); err != nil {
+		return err
+	}
+	if err := validateBytes("payload", r.Payload, 1, 128); err != nil {
+		return err
+	}
+	if err := validateRequired("provider_token", r.ProviderToken != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("currency", r.Currency != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *AnswerShippingQueryRequest) Validate() error {
+	if err := validateRequired("shipping_query_id", r.ShippingQueryID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *AnswerPreCheckoutQueryRequest) Validate() error {
+	if err := validateRequired("pre_checkout_query_id", r.PreCheckoutQueryID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SendStickerRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("sticker", r.Sticker != nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *GetStickerSetRequest) Validate() error {
+	if err := validateRequired("name", r.Name != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *UploadStickerFileRequest) Validate() error {
+	if err := validateRequired("png_sticker", r.PngSticker != nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *CreateNewStickerSetRequest) Validate() error {
+	if err := validateLength("name", r.Name, 1, 64); err != nil {
+		return err
+	}
+	if err := validateLength("title", r.Title, 1, 64); err != nil {
+		return err
+	}
+	if err := validateRequired("emojis", r.Emojis != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *AddStickerToSetRequest) Validate() error {
+	if err := validateRequired("name", r.Name != ""); err != nil {
+		return err
+	}
+	if err := validateRequired("emojis", r.Emojis != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SetStickerPositionInSetRequest) Validate() error {
+	if err := validateRequired("sticker", r.Sticker != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *DeleteStickerFromSetRequest) Validate() error {
+	if err := validateRequired("sticker", r.Sticker != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SetStickerSetThumbRequest) Validate() error {
+	if err := validateRequired("name", r.Name != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *SetPassportDataErrorsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *EditMessageTextRequest) Validate() error {
+	if err := validateRequired("text", r.Text != ""); err != nil {
+		return err
+	}
+	if r.ParseMode == "" {
+		if err := validateLength("text", r.Text, 1, 4096); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *EditMessageCaptionRequest) Validate() error {
+	if r.Caption != "" {
+		if r.ParseMode == "" {
+			if err := validateLength("caption", r.Caption, 0, 1024); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *EditMessageMediaRequest) Validate() error {
+	if err := validateRequired("media", r.Media != nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *EditMessageReplyMarkupRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *StopPollRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the request against limits described in the documentation.
+func (r *DeleteMessageRequest) Validate() error {
+	if err := validateRequired("chat_id", r.ChatID != ""); err != nil {
+		return err
+	}
+	return nil
+}
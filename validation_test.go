@@ -0,0 +1,80 @@
+package telegram
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.Nil(t, (&SendMessageRequest{ChatID: "1", Text: "hello"}).Validate())
+	assert.NotNil(t, (&SendMessageRequest{ChatID: "1"}).Validate())
+	assert.NotNil(t, (&SendMessageRequest{Text: "hello"}).Validate())
+
+	long := strings.Repeat("a", 4097)
+	assert.NotNil(t, (&SendMessageRequest{ChatID: "1", Text: long}).Validate())
+	// length with markup is checked by telegram after entities parsing
+	assert.Nil(t, (&SendMessageRequest{ChatID: "1", Text: long, ParseMode: "HTML"}).Validate())
+	// but the text is still required
+	assert.NotNil(t, (&SendMessageRequest{ChatID: "1", ParseMode: "HTML"}).Validate())
+
+	// emoji takes two UTF-16 code units
+	assert.NotNil(t, (&SendMessageRequest{ChatID: "1", Text: strings.Repeat("😀", 2049)}).Validate())
+	assert.Nil(t, (&SendMessageRequest{ChatID: "1", Text: strings.Repeat("😀", 2048)}).Validate())
+
+	assert.Nil(t, (&SendChatActionRequest{ChatID: "1", Action: "typing"}).Validate())
+	assert.NotNil(t, (&SendChatActionRequest{ChatID: "1", Action: "dancing"}).Validate())
+
+	assert.Nil(t, (&GetUpdatesRequest{}).Validate())
+	assert.NotNil(t, (&GetUpdatesRequest{Limit: 101}).Validate())
+
+	assert.Nil(t, (&SendPollRequest{ChatID: "1", Question: "?", Options: []string{"a", "b"}}).Validate())
+	assert.NotNil(t, (&SendPollRequest{ChatID: "1", Question: "?", Options: []string{"a"}}).Validate())
+	assert.NotNil(t, (&SendPollRequest{ChatID: "1", Question: "?", Options: []string{"a", ""}}).Validate())
+
+	// empty url removes webhook
+	assert.Nil(t, (&SetWebhookRequest{}).Validate())
+}
+
+func TestValidationMiddleware(t *testing.T) {
+	called := false
+	handler := ValidationMiddleware(func(methodName string, req interface{}) (json.RawMessage, error) {
+		called = true
+		return nil, nil
+	})
+
+	_, err := handler("sendMessage", &SendMessageRequest{ChatID: "1"})
+	assert.NotNil(t, err)
+	assert.False(t, called)
+
+	var verr *ValidationError
+	assert.True(t, errors.As(err, &verr))
+	assert.Equal(t, "text", verr.Field)
+
+	_, err = handler("sendMessage", &SendMessageRequest{ChatID: "1", Text: "hello"})
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+func TestEnums(t *testing.T) {
+	assert.True(t, ChatTypeSupergroup.IsValid())
+	assert.False(t, ChatType("unknown").IsValid())
+	assert.False(t, ChatType("sender").IsValid())
+	assert.True(t, InlineQueryChatTypeSender.IsValid())
+
+	assert.Nil(t, (&SendDiceRequest{ChatID: "1", Emoji: DiceEmojiDarts}).Validate())
+	assert.NotNil(t, (&SendDiceRequest{ChatID: "1", Emoji: "🍕"}).Validate())
+
+	// enums are plain strings on the wire
+	var chat Chat
+	err := json.Unmarshal([]byte(`{"id":1,"type":"private"}`), &chat)
+	assert.Nil(t, err)
+	assert.Equal(t, ChatTypePrivate, chat.Type)
+
+	j, err := json.Marshal(&SendMessageRequest{ChatID: "1", Text: "a", ParseMode: ParseModeHTML})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"chat_id":"1","text":"a","parse_mode":"HTML"}`, string(j))
+}
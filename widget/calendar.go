@@ -0,0 +1,238 @@
+package widget
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/callback"
+	"github.com/petuhovskiy/telegram/markup"
+	"github.com/petuhovskiy/telegram/updates"
+)
+
+// Locale contains names for the calendar.
+type Locale struct {
+	Months       [12]string
+	Weekdays     [7]string // starting from Sunday, like time.Weekday
+	FirstWeekday time.Weekday
+}
+
+var LocaleEnglish = Locale{
+	Months:       [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	Weekdays:     [7]string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"},
+	FirstWeekday: time.Sunday,
+}
+
+var LocaleRussian = Locale{
+	Months:       [12]string{"Январь", "Февраль", "Март", "Апрель", "Май", "Июнь", "Июль", "Август", "Сентябрь", "Октябрь", "Ноябрь", "Декабрь"},
+	Weekdays:     [7]string{"Вс", "Пн", "Вт", "Ср", "Чт", "Пт", "Сб"},
+	FirstWeekday: time.Monday,
+}
+
+var LocaleGerman = Locale{
+	Months:       [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	Weekdays:     [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	FirstWeekday: time.Monday,
+}
+
+const (
+	actionNoop   = ""
+	actionShow   = "s"
+	actionSelect = "d"
+)
+
+// calendarData is the callback data, date is encoded as yyyymmdd.
+type calendarData struct {
+	Action string
+	Date   int
+}
+
+func dateKey(t time.Time) int {
+	return t.Year()*10000 + int(t.Month())*100 + t.Day()
+}
+
+func keyDate(key int, loc *time.Location) time.Time {
+	return time.Date(key/10000, time.Month(key/100%100), key%100, 0, 0, 0, 0, loc)
+}
+
+var errDateDisabled = errors.New("date is disabled")
+
+// Calendar shows month view with dates, which can be selected.
+type Calendar struct {
+	bot      *telegram.Bot
+	codec    *callback.Codec
+	onSelect func(q *telegram.CallbackQuery, date time.Time)
+
+	Locale   Locale
+	Location *time.Location
+
+	// Min and Max limit the dates, zero value means no limit.
+	Min time.Time
+	Max time.Time
+
+	// Disabled reports whether the date can't be selected, optional.
+	Disabled func(date time.Time) bool
+}
+
+// NewCalendar registers callback handler of the calendar in the router.
+// Prefix must be unique for the router.
+func NewCalendar(bot *telegram.Bot, router *updates.Router, prefix string, onSelect func(q *telegram.CallbackQuery, date time.Time)) *Calendar {
+	codec := callback.NewCodec()
+	codec.MustRegister(prefix, calendarData{})
+
+	c := &Calendar{
+		bot:      bot,
+		codec:    codec,
+		onSelect: onSelect,
+		Locale:   LocaleEnglish,
+		Location: time.UTC,
+	}
+
+	router.HandleCallback(prefix, c.handleCallback)
+	return c
+}
+
+func (c *Calendar) button(text string, action string, date int) (telegram.InlineKeyboardButton, error) {
+	return c.codec.Button(text, calendarData{Action: action, Date: date})
+}
+
+// isAllowed reports whether the date can be selected.
+func (c *Calendar) isAllowed(date time.Time) bool {
+	key := dateKey(date)
+	if !c.Min.IsZero() && key < dateKey(c.Min.In(c.Location)) {
+		return false
+	}
+	if !c.Max.IsZero() && key > dateKey(c.Max.In(c.Location)) {
+		return false
+	}
+	if c.Disabled != nil && c.Disabled(date) {
+		return false
+	}
+	return true
+}
+
+// Keyboard returns month view for the month of the date. callback.ErrTooLong
+// is returned when the prefix is too long for the callback data.
+func (c *Calendar) Keyboard(month time.Time) (*telegram.InlineKeyboardMarkup, error) {
+	// the first error of the buttons is returned
+	var err error
+	button := func(text string, action string, date int) telegram.InlineKeyboardButton {
+		b, buttonErr := c.button(text, action, date)
+		if err == nil {
+			err = buttonErr
+		}
+		return b
+	}
+
+	month = month.In(c.Location)
+	first := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, c.Location)
+	prev := first.AddDate(0, -1, 0)
+	next := first.AddDate(0, 1, 0)
+
+	prevButton := button(" ", actionNoop, 0)
+	if c.Min.IsZero() || dateKey(prev.AddDate(0, 1, -1)) >= dateKey(c.Min.In(c.Location)) {
+		prevButton = button("‹", actionShow, dateKey(prev))
+	}
+
+	nextButton := button(" ", actionNoop, 0)
+	if c.Max.IsZero() || dateKey(next) <= dateKey(c.Max.In(c.Location)) {
+		nextButton = button("›", actionShow, dateKey(next))
+	}
+
+	title := c.Locale.Months[first.Month()-1] + " " + strconv.Itoa(first.Year())
+	keyboard := [][]telegram.InlineKeyboardButton{
+		{prevButton, button(title, actionNoop, 0), nextButton},
+	}
+
+	var header []telegram.InlineKeyboardButton
+	for i := 0; i < 7; i++ {
+		day := (int(c.Locale.FirstWeekday) + i) % 7
+		header = append(header, button(c.Locale.Weekdays[day], actionNoop, 0))
+	}
+	keyboard = append(keyboard, header)
+
+	var days []telegram.InlineKeyboardButton
+	padding := (int(first.Weekday()) - int(c.Locale.FirstWeekday) + 7) % 7
+	for i := 0; i < padding; i++ {
+		days = append(days, button(" ", actionNoop, 0))
+	}
+
+	for date := first; date.Month() == first.Month(); date = date.AddDate(0, 0, 1) {
+		if c.isAllowed(date) {
+			days = append(days, button(strconv.Itoa(date.Day()), actionSelect, dateKey(date)))
+		} else {
+			days = append(days, button("·", actionNoop, 0))
+		}
+	}
+
+	for len(days)%7 != 0 {
+		days = append(days, button(" ", actionNoop, 0))
+	}
+
+	keyboard = append(keyboard, markup.InlineKeyboardRows(7, days)...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &telegram.InlineKeyboardMarkup{
+		InlineKeyboard: keyboard,
+	}, nil
+}
+
+// Send sends the message with calendar for the month of the date.
+func (c *Calendar) Send(chatID string, text string, month time.Time) (*telegram.Message, error) {
+	kb, err := c.Keyboard(month)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.bot.SendMessage(&telegram.SendMessageRequest{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: kb,
+	})
+}
+
+func (c *Calendar) handleCallback(q *telegram.CallbackQuery) {
+	err := c.press(q)
+	if err != nil {
+		log.WithError(err).WithField("data", q.Data).Error("failed to handle calendar button")
+	}
+
+	err = answer(c.bot, q)
+	if err != nil {
+		log.WithError(err).Error("failed to answer callback query")
+	}
+}
+
+func (c *Calendar) press(q *telegram.CallbackQuery) error {
+	v, err := c.codec.Decode(q.Data)
+	if err != nil {
+		return err
+	}
+	data := v.(*calendarData)
+
+	switch data.Action {
+	case actionShow:
+		kb, err := c.Keyboard(keyDate(data.Date, c.Location))
+		if err != nil {
+			return err
+		}
+
+		return editKeyboard(c.bot, q, kb)
+
+	case actionSelect:
+		date := keyDate(data.Date, c.Location)
+		if !c.isAllowed(date) {
+			return errDateDisabled
+		}
+
+		c.onSelect(q, date)
+	}
+
+	return nil
+}
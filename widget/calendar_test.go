@@ -0,0 +1,102 @@
+package widget
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/callback"
+	"github.com/petuhovskiy/telegram/internal/telegramtest"
+	"github.com/petuhovskiy/telegram/updates"
+)
+
+func pressButton(router *updates.Router, rec *telegramtest.Recorder, data string) {
+	rec.Reset()
+	router.HandleUpdate(&telegram.Update{CallbackQuery: &telegram.CallbackQuery{
+		ID:      "q",
+		Message: &telegram.Message{MessageID: 5, Chat: &telegram.Chat{ID: 1}},
+		Data:    data,
+	}})
+}
+
+func TestCalendar(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	var selected time.Time
+
+	router := updates.NewRouter()
+	c := NewCalendar(rec.Bot(), router, "cal", func(q *telegram.CallbackQuery, date time.Time) {
+		selected = date
+	})
+	c.Locale = LocaleRussian
+	c.Min = time.Date(2021, time.May, 10, 15, 0, 0, 0, time.UTC)
+	c.Disabled = func(date time.Time) bool {
+		return date.Weekday() == time.Sunday
+	}
+
+	res, err := c.Keyboard(time.Date(2021, time.May, 20, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	kb := res.InlineKeyboard
+	assert.Equal(t, []string{" ", "Май 2021", "›"}, buttonTexts(kb[0]))
+	assert.Equal(t, []string{"Пн", "Вт", "Ср", "Чт", "Пт", "Сб", "Вс"}, buttonTexts(kb[1]))
+	// May 1, 2021 is Saturday
+	assert.Equal(t, []string{" ", " ", " ", " ", " ", "·", "·"}, buttonTexts(kb[2]))
+	assert.Equal(t, []string{"10", "11", "12", "13", "14", "15", "·"}, buttonTexts(kb[4]))
+	assert.Equal(t, []string{"31", " ", " ", " ", " ", " ", " "}, buttonTexts(kb[7]))
+
+	pressButton(router, rec, kb[0][2].CallbackData)
+	edit := rec.Requests()[0].Req.(*telegram.EditMessageReplyMarkupRequest)
+	assert.Equal(t, "Июнь 2021", edit.ReplyMarkup.InlineKeyboard[0][1].Text)
+	assert.Equal(t, "‹", edit.ReplyMarkup.InlineKeyboard[0][0].Text)
+
+	pressButton(router, rec, kb[4][2].CallbackData)
+	assert.Equal(t, time.Date(2021, time.May, 12, 0, 0, 0, 0, time.UTC), selected)
+}
+
+func TestTimePicker(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	var selected time.Time
+
+	router := updates.NewRouter()
+	p := NewTimePicker(rec.Bot(), router, "time", func(q *telegram.CallbackQuery, t time.Time) {
+		selected = t
+	})
+	p.Step = 15 * time.Minute
+
+	res, err := p.Keyboard(time.Date(2021, time.May, 20, 23, 50, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	kb := res.InlineKeyboard
+	assert.Equal(t, []string{"23", "45"}, buttonTexts(kb[1]))
+
+	pressButton(router, rec, kb[0][0].CallbackData)
+	kb = rec.Requests()[0].Req.(*telegram.EditMessageReplyMarkupRequest).ReplyMarkup.InlineKeyboard
+	assert.Equal(t, []string{"00", "45"}, buttonTexts(kb[1]))
+
+	pressButton(router, rec, kb[0][1].CallbackData)
+	kb = rec.Requests()[0].Req.(*telegram.EditMessageReplyMarkupRequest).ReplyMarkup.InlineKeyboard
+	assert.Equal(t, []string{"00", "00"}, buttonTexts(kb[1]))
+
+	pressButton(router, rec, kb[3][0].CallbackData)
+	assert.Equal(t, time.Date(2021, time.May, 20, 0, 0, 0, 0, time.UTC), selected)
+}
+
+func TestPickersLongPrefix(t *testing.T) {
+	router := updates.NewRouter()
+	prefix := strings.Repeat("p", 60)
+	now := time.Date(2021, time.May, 20, 0, 0, 0, 0, time.UTC)
+
+	c := NewCalendar((&telegramtest.Recorder{}).Bot(), router, prefix, nil)
+	_, err := c.Keyboard(now)
+	assert.True(t, errors.Is(err, callback.ErrTooLong))
+	_, err = c.Send("1", "date", now)
+	assert.True(t, errors.Is(err, callback.ErrTooLong))
+
+	p := NewTimePicker((&telegramtest.Recorder{}).Bot(), router, prefix+"t", nil)
+	_, err = p.Keyboard(now)
+	assert.True(t, errors.Is(err, callback.ErrTooLong))
+	_, err = p.Send("1", "time", now)
+	assert.True(t, errors.Is(err, callback.ErrTooLong))
+}
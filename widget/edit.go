@@ -0,0 +1,59 @@
+package widget
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/petuhovskiy/telegram"
+)
+
+// checkEdit ignores errors, which don't mean that the edit failed.
+func checkEdit(err error, inline bool) error {
+	var typeErr *json.UnmarshalTypeError
+	if inline && errors.As(err, &typeErr) {
+		// telegram returns true instead of the message for inline messages
+		return nil
+	}
+
+	if telegram.IsMessageNotModified(err) {
+		return nil
+	}
+
+	return err
+}
+
+// editMessage replaces text and keyboard of the message with the callback
+// button. Not modified message is not an error.
+func editMessage(bot *telegram.Bot, q *telegram.CallbackQuery, req *telegram.EditMessageTextRequest) error {
+	req.InlineMessageID = q.InlineMessageID
+	if q.Message != nil {
+		req.ChatID = strconv.Itoa(q.Message.Chat.ID)
+		req.MessageID = q.Message.MessageID
+	}
+
+	_, err := bot.EditMessageText(req)
+	return checkEdit(err, req.InlineMessageID != "")
+}
+
+// editKeyboard replaces keyboard of the message with the callback button.
+func editKeyboard(bot *telegram.Bot, q *telegram.CallbackQuery, keyboard *telegram.InlineKeyboardMarkup) error {
+	req := &telegram.EditMessageReplyMarkupRequest{
+		InlineMessageID: q.InlineMessageID,
+		ReplyMarkup:     keyboard,
+	}
+	if q.Message != nil {
+		req.ChatID = strconv.Itoa(q.Message.Chat.ID)
+		req.MessageID = q.Message.MessageID
+	}
+
+	_, err := bot.EditMessageReplyMarkup(req)
+	return checkEdit(err, req.InlineMessageID != "")
+}
+
+func answer(bot *telegram.Bot, q *telegram.CallbackQuery) error {
+	_, err := bot.AnswerCallbackQuery(&telegram.AnswerCallbackQueryRequest{
+		CallbackQueryID: q.ID,
+	})
+	return err
+}
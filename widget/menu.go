@@ -0,0 +1,376 @@
+package widget
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/callback"
+	"github.com/petuhovskiy/telegram/updates"
+)
+
+// MenuContext describes the chat, where the menu is shown.
+type MenuContext struct {
+	Bot    *telegram.Bot
+	ChatID string
+
+	// Query is the pressed button, nil when the menu is sent.
+	Query *telegram.CallbackQuery
+}
+
+// Menu is a screen with text and items, each item takes a row of the keyboard.
+type Menu struct {
+	// Title is the text of the button, which opens the menu as a submenu.
+	Title string
+
+	Text      string
+	ParseMode telegram.ParseMode
+
+	// TextFunc overrides Text, if set.
+	TextFunc func(ctx *MenuContext) string
+
+	Items []MenuItem
+}
+
+type itemKind int
+
+const (
+	itemSubmenu itemKind = iota
+	itemAction
+	itemToggle
+	itemRadio
+	itemURL
+	itemDynamic
+)
+
+// RadioOption is one of the values in the radio group.
+type RadioOption struct {
+	Value string
+	Text  string
+}
+
+// MenuItem is a row of the menu keyboard, use constructors to create it.
+type MenuItem struct {
+	kind    itemKind
+	text    string
+	submenu *Menu
+	url     string
+	action  func(ctx *MenuContext) error
+
+	getToggle func(ctx *MenuContext) bool
+	setToggle func(ctx *MenuContext, value bool) error
+
+	options  []RadioOption
+	getRadio func(ctx *MenuContext) string
+	setRadio func(ctx *MenuContext, value string) error
+
+	dynamic func(ctx *MenuContext) []MenuItem
+}
+
+// Submenu opens the menu, which has a back button.
+func Submenu(m *Menu) MenuItem {
+	return MenuItem{kind: itemSubmenu, text: m.Title, submenu: m}
+}
+
+// Action calls the function and redraws the menu.
+func Action(text string, action func(ctx *MenuContext) error) MenuItem {
+	return MenuItem{kind: itemAction, text: text, action: action}
+}
+
+// Toggle shows checkbox, which is switched on press.
+func Toggle(text string, get func(ctx *MenuContext) bool, set func(ctx *MenuContext, value bool) error) MenuItem {
+	return MenuItem{kind: itemToggle, text: text, getToggle: get, setToggle: set}
+}
+
+// Radio shows options in a row, the selected one is marked.
+func Radio(options []RadioOption, get func(ctx *MenuContext) string, set func(ctx *MenuContext, value string) error) MenuItem {
+	return MenuItem{kind: itemRadio, options: options, getRadio: get, setRadio: set}
+}
+
+// URL opens the link.
+func URL(text string, url string) MenuItem {
+	return MenuItem{kind: itemURL, text: text, url: url}
+}
+
+// Dynamic returns items, which are computed each time the menu is shown.
+// Items must be the same for the same context, as they're addressed by index.
+func Dynamic(items func(ctx *MenuContext) []MenuItem) MenuItem {
+	return MenuItem{kind: itemDynamic, dynamic: items}
+}
+
+// flatten expands dynamic items.
+func flatten(ctx *MenuContext, items []MenuItem) []MenuItem {
+	var res []MenuItem
+	for _, item := range items {
+		if item.kind == itemDynamic {
+			res = append(res, flatten(ctx, item.dynamic(ctx))...)
+			continue
+		}
+		res = append(res, item)
+	}
+	return res
+}
+
+// menuData is the callback data. Path is the chain of submenu item indexes
+// from the root menu, Item is -1 to show the menu.
+type menuData struct {
+	Path  string
+	Item  int
+	Value string
+}
+
+const pathSeparator = "."
+
+// Menus shows the menu tree and handles its buttons.
+type Menus struct {
+	bot   *telegram.Bot
+	codec *callback.Codec
+	root  *Menu
+
+	BackText string
+}
+
+// NewMenus registers callback handler of the menu tree in the router. Prefix
+// must be unique for the router.
+func NewMenus(bot *telegram.Bot, router *updates.Router, prefix string, root *Menu) *Menus {
+	codec := callback.NewCodec()
+	codec.MustRegister(prefix, menuData{})
+
+	m := &Menus{
+		bot:      bot,
+		codec:    codec,
+		root:     root,
+		BackText: "« Back",
+	}
+
+	router.HandleCallback(prefix, m.handleCallback)
+	return m
+}
+
+var (
+	errUnknownMenu   = errors.New("unknown menu")
+	errUnknownOption = errors.New("unknown radio option")
+)
+
+// find returns menu by the path.
+func (m *Menus) find(ctx *MenuContext, path string) (*Menu, error) {
+	menu := m.root
+	if path == "" {
+		return menu, nil
+	}
+
+	for _, s := range strings.Split(path, pathSeparator) {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, err
+		}
+
+		items := flatten(ctx, menu.Items)
+		if i < 0 || i >= len(items) || items[i].kind != itemSubmenu {
+			return nil, errUnknownMenu
+		}
+		menu = items[i].submenu
+	}
+
+	return menu, nil
+}
+
+func parentPath(path string) string {
+	i := strings.LastIndex(path, pathSeparator)
+	if i < 0 {
+		return ""
+	}
+	return path[:i]
+}
+
+func childPath(path string, item int) string {
+	if path == "" {
+		return strconv.Itoa(item)
+	}
+	return path + pathSeparator + strconv.Itoa(item)
+}
+
+// SetStorage enables long radio values and deep submenus, which don't fit
+// into the callback data. The router must resolve data of the same storage,
+// see Router.SetStorage.
+func (m *Menus) SetStorage(s *callback.Storage) {
+	m.codec.SetStorage(s)
+}
+
+// keyboard returns buttons of the menu. callback.ErrTooLong is returned, if
+// the data of a button is too long and the storage is not set.
+func (m *Menus) keyboard(ctx *MenuContext, menu *Menu, path string) (*telegram.InlineKeyboardMarkup, error) {
+	var keyboard [][]telegram.InlineKeyboardButton
+
+	// the first error is returned after the keyboard is built
+	var err error
+	button := func(text string, data menuData) telegram.InlineKeyboardButton {
+		b, encodeErr := m.codec.Button(text, data)
+		if encodeErr != nil && err == nil {
+			err = encodeErr
+		}
+		return b
+	}
+
+	for i, item := range flatten(ctx, menu.Items) {
+		switch item.kind {
+		case itemSubmenu:
+			keyboard = append(keyboard, []telegram.InlineKeyboardButton{
+				button(item.text, menuData{Path: childPath(path, i), Item: -1}),
+			})
+
+		case itemAction:
+			keyboard = append(keyboard, []telegram.InlineKeyboardButton{
+				button(item.text, menuData{Path: path, Item: i}),
+			})
+
+		case itemToggle:
+			mark := "⬜ "
+			if item.getToggle(ctx) {
+				mark = "✅ "
+			}
+			keyboard = append(keyboard, []telegram.InlineKeyboardButton{
+				button(mark+item.text, menuData{Path: path, Item: i}),
+			})
+
+		case itemRadio:
+			selected := item.getRadio(ctx)
+
+			var row []telegram.InlineKeyboardButton
+			for _, opt := range item.options {
+				mark := "○ "
+				if opt.Value == selected {
+					mark = "● "
+				}
+				row = append(row, button(mark+opt.Text, menuData{Path: path, Item: i, Value: opt.Value}))
+			}
+			keyboard = append(keyboard, row)
+
+		case itemURL:
+			keyboard = append(keyboard, []telegram.InlineKeyboardButton{{
+				Text: item.text,
+				URL:  item.url,
+			}})
+		}
+	}
+
+	if menu != m.root {
+		keyboard = append(keyboard, []telegram.InlineKeyboardButton{
+			button(m.BackText, menuData{Path: parentPath(path), Item: -1}),
+		})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &telegram.InlineKeyboardMarkup{
+		InlineKeyboard: keyboard,
+	}, nil
+}
+
+func menuText(ctx *MenuContext, menu *Menu) string {
+	if menu.TextFunc != nil {
+		return menu.TextFunc(ctx)
+	}
+	return menu.Text
+}
+
+// Send sends the root menu to the chat.
+func (m *Menus) Send(chatID string) (*telegram.Message, error) {
+	ctx := &MenuContext{
+		Bot:    m.bot,
+		ChatID: chatID,
+	}
+
+	kb, err := m.keyboard(ctx, m.root, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return m.bot.SendMessage(&telegram.SendMessageRequest{
+		ChatID:      chatID,
+		Text:        menuText(ctx, m.root),
+		ParseMode:   m.root.ParseMode,
+		ReplyMarkup: kb,
+	})
+}
+
+func (m *Menus) handleCallback(q *telegram.CallbackQuery) {
+	err := m.press(q)
+	if err != nil {
+		log.WithError(err).WithField("data", q.Data).Error("failed to handle menu button")
+	}
+
+	err = answer(m.bot, q)
+	if err != nil {
+		log.WithError(err).Error("failed to answer callback query")
+	}
+}
+
+// press handles the button and redraws the menu.
+func (m *Menus) press(q *telegram.CallbackQuery) error {
+	v, err := m.codec.Decode(q.Data)
+	if err != nil {
+		return err
+	}
+	data := v.(*menuData)
+
+	ctx := &MenuContext{
+		Bot:   m.bot,
+		Query: q,
+	}
+	if q.Message != nil {
+		ctx.ChatID = strconv.Itoa(q.Message.Chat.ID)
+	}
+
+	menu, err := m.find(ctx, data.Path)
+	if err != nil {
+		return err
+	}
+
+	if data.Item >= 0 {
+		err = m.apply(ctx, menu, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	kb, err := m.keyboard(ctx, menu, data.Path)
+	if err != nil {
+		return err
+	}
+
+	return editMessage(m.bot, q, &telegram.EditMessageTextRequest{
+		Text:        menuText(ctx, menu),
+		ParseMode:   menu.ParseMode,
+		ReplyMarkup: kb,
+	})
+}
+
+func (m *Menus) apply(ctx *MenuContext, menu *Menu, data *menuData) error {
+	items := flatten(ctx, menu.Items)
+	if data.Item >= len(items) {
+		return errUnknownMenu
+	}
+
+	item := items[data.Item]
+	switch item.kind {
+	case itemAction:
+		return item.action(ctx)
+	case itemToggle:
+		return item.setToggle(ctx, !item.getToggle(ctx))
+	case itemRadio:
+		for _, opt := range item.options {
+			if opt.Value == data.Value {
+				return item.setRadio(ctx, data.Value)
+			}
+		}
+		return errUnknownOption
+	}
+
+	return nil
+}
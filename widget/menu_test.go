@@ -0,0 +1,140 @@
+package widget
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/callback"
+	"github.com/petuhovskiy/telegram/internal/telegramtest"
+	"github.com/petuhovskiy/telegram/updates"
+)
+
+func TestMenus(t *testing.T) {
+	notifications := false
+	lang := "en"
+	var actions []string
+
+	settings := &Menu{
+		Title: "Settings",
+		Text:  "Settings",
+		Items: []MenuItem{
+			Toggle("Notifications",
+				func(ctx *MenuContext) bool { return notifications },
+				func(ctx *MenuContext, v bool) error { notifications = v; return nil },
+			),
+			Radio([]RadioOption{{"en", "English"}, {"de", "Deutsch"}},
+				func(ctx *MenuContext) string { return lang },
+				func(ctx *MenuContext, v string) error { lang = v; return nil },
+			),
+		},
+	}
+
+	root := &Menu{
+		TextFunc: func(ctx *MenuContext) string { return "Main menu, chat " + ctx.ChatID },
+		Items: []MenuItem{
+			Dynamic(func(ctx *MenuContext) []MenuItem {
+				return []MenuItem{
+					Action("Hello", func(ctx *MenuContext) error {
+						actions = append(actions, "hello")
+						return nil
+					}),
+				}
+			}),
+			Submenu(settings),
+			URL("Site", "https://example.com"),
+		},
+	}
+
+	rec := &telegramtest.Recorder{}
+	router := updates.NewRouter()
+	m := NewMenus(rec.Bot(), router, "menu", root)
+
+	_, err := m.Send("1")
+	assert.Nil(t, err)
+	sent := rec.Last().Req.(*telegram.SendMessageRequest)
+	assert.Equal(t, "Main menu, chat 1", sent.Text)
+
+	kb := sent.ReplyMarkup.(*telegram.InlineKeyboardMarkup).InlineKeyboard
+	assert.Len(t, kb, 3)
+	assert.Equal(t, "https://example.com", kb[2][0].URL)
+
+	press := func(data string) [][]telegram.InlineKeyboardButton {
+		rec.Reset()
+		router.HandleUpdate(&telegram.Update{CallbackQuery: &telegram.CallbackQuery{
+			ID:      "q",
+			Message: &telegram.Message{MessageID: 5, Chat: &telegram.Chat{ID: 1}},
+			Data:    data,
+		}})
+
+		reqs := rec.Requests()
+		assert.Equal(t, "answerCallbackQuery", reqs[len(reqs)-1].Method)
+		return reqs[0].Req.(*telegram.EditMessageTextRequest).ReplyMarkup.InlineKeyboard
+	}
+
+	press(kb[0][0].CallbackData)
+	assert.Equal(t, []string{"hello"}, actions)
+
+	kb = press(kb[1][0].CallbackData)
+	assert.Equal(t, "⬜ Notifications", kb[0][0].Text)
+	assert.Equal(t, []string{"● English", "○ Deutsch"}, buttonTexts(kb[1]))
+	assert.Equal(t, "« Back", kb[2][0].Text)
+
+	kb = press(kb[0][0].CallbackData)
+	assert.True(t, notifications)
+	assert.Equal(t, "✅ Notifications", kb[0][0].Text)
+
+	kb = press(kb[1][1].CallbackData)
+	assert.Equal(t, "de", lang)
+	assert.Equal(t, []string{"○ English", "● Deutsch"}, buttonTexts(kb[1]))
+
+	kb = press(kb[2][0].CallbackData)
+	assert.Len(t, kb, 3)
+	assert.Equal(t, "Settings", kb[1][0].Text)
+}
+
+func TestMenusRadioValue(t *testing.T) {
+	lang := "en"
+	long := strings.Repeat("x", 100)
+	root := &Menu{
+		Text: "Menu",
+		Items: []MenuItem{
+			Radio([]RadioOption{{"en", "English"}, {long, "Long"}},
+				func(ctx *MenuContext) string { return lang },
+				func(ctx *MenuContext, v string) error { lang = v; return nil },
+			),
+		},
+	}
+
+	rec := &telegramtest.Recorder{}
+	router := updates.NewRouter()
+	m := NewMenus(rec.Bot(), router, "menu", root)
+
+	_, err := m.Send("1")
+	assert.True(t, errors.Is(err, callback.ErrTooLong))
+
+	storage := callback.NewStorage(callback.NewMemoryStore(10), time.Hour)
+	router.SetStorage(storage)
+	m.SetStorage(storage)
+
+	_, err = m.Send("1")
+	assert.Nil(t, err)
+	kb := rec.Last().Req.(*telegram.SendMessageRequest).ReplyMarkup.(*telegram.InlineKeyboardMarkup).InlineKeyboard
+
+	q := &telegram.CallbackQuery{
+		ID:      "q",
+		Message: &telegram.Message{MessageID: 5, Chat: &telegram.Chat{ID: 1}},
+		Data:    kb[0][1].CallbackData,
+	}
+	assert.Nil(t, m.press(q))
+	assert.Equal(t, long, lang)
+
+	// forged value, which is not in the options
+	q.Data = m.codec.MustEncode(menuData{Item: 0, Value: "fr"})
+	assert.Equal(t, errUnknownOption, m.press(q))
+	assert.Equal(t, long, lang)
+}
@@ -0,0 +1,213 @@
+package widget
+
+import (
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/callback"
+	"github.com/petuhovskiy/telegram/markup"
+	"github.com/petuhovskiy/telegram/updates"
+)
+
+// Page is a rendered page of the list.
+type Page struct {
+	Text      string
+	ParseMode telegram.ParseMode
+	Entities  []telegram.MessageEntity
+
+	// Buttons of the items, shown above the navigation.
+	Buttons []telegram.InlineKeyboardButton
+
+	// Total count of pages.
+	Total int
+}
+
+// PageFunc returns the page of the list, identified by key. Pages are
+// numbered from zero.
+type PageFunc func(key string, page int) (*Page, error)
+
+type pageData struct {
+	Page int
+	Key  string
+}
+
+// Paginator sends the list page by page and edits the message in place,
+// when the navigation buttons are pressed.
+type Paginator struct {
+	bot   *telegram.Bot
+	codec *callback.Codec
+	pages PageFunc
+
+	// RowSize is the max count of item buttons in a row.
+	RowSize int
+
+	// Window is the count of page buttons in the navigation row, at least 3.
+	Window int
+}
+
+// NewPaginator creates paginator and registers its callback handler in
+// the router. Prefix must be unique for the router.
+func NewPaginator(bot *telegram.Bot, router *updates.Router, prefix string, pages PageFunc) *Paginator {
+	codec := callback.NewCodec()
+	codec.MustRegister(prefix, pageData{})
+
+	p := &Paginator{
+		bot:     bot,
+		codec:   codec,
+		pages:   pages,
+		RowSize: 1,
+		Window:  5,
+	}
+
+	router.HandleCallback(prefix, p.handleCallback)
+	return p
+}
+
+// SetStorage enables keys, which don't fit into the callback data. The
+// router must resolve data of the same storage, see Router.SetStorage.
+func (p *Paginator) SetStorage(s *callback.Storage) {
+	p.codec.SetStorage(s)
+}
+
+func (p *Paginator) button(text string, key string, page int) (telegram.InlineKeyboardButton, error) {
+	return p.codec.Button(text, pageData{Page: page, Key: key})
+}
+
+// window returns range of pages shown in the navigation row.
+func (p *Paginator) window(page, total int) (int, int) {
+	size := p.Window
+	if size < 3 {
+		// the current page and the links to the first and the last pages
+		size = 3
+	}
+	if size > total {
+		size = total
+	}
+
+	start := page - size/2
+	if start+size > total {
+		start = total - size
+	}
+	if start < 0 {
+		start = 0
+	}
+
+	return start, start + size
+}
+
+// Navigation returns buttons to jump to the pages around the current one,
+// the first and the last pages. callback.ErrTooLong is returned for long keys,
+// unless the storage is set.
+func (p *Paginator) Navigation(key string, page, total int) ([]telegram.InlineKeyboardButton, error) {
+	if total <= 1 {
+		return nil, nil
+	}
+
+	start, end := p.window(page, total)
+
+	var buttons []telegram.InlineKeyboardButton
+	for i := start; i < end; i++ {
+		target := i
+		text := strconv.Itoa(i + 1)
+
+		switch {
+		case i == page:
+			text = "·" + text + "·"
+		case i == start && start > 0:
+			target = 0
+			text = "« 1"
+		case i == end-1 && end < total:
+			target = total - 1
+			text = strconv.Itoa(total) + " »"
+		}
+
+		button, err := p.button(text, key, target)
+		if err != nil {
+			return nil, err
+		}
+		buttons = append(buttons, button)
+	}
+
+	return buttons, nil
+}
+
+// Keyboard returns item buttons with the navigation row below.
+func (p *Paginator) Keyboard(key string, page int, content *Page) (*telegram.InlineKeyboardMarkup, error) {
+	keyboard := markup.InlineKeyboardRows(p.RowSize, content.Buttons)
+
+	nav, err := p.Navigation(key, page, content.Total)
+	if err != nil {
+		return nil, err
+	}
+	if len(nav) != 0 {
+		keyboard = append(keyboard, nav)
+	}
+
+	return markup.InlineKeyboardMarkup(keyboard), nil
+}
+
+// Send sends the first page of the list.
+func (p *Paginator) Send(chatID string, key string) (*telegram.Message, error) {
+	content, err := p.pages(key, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	kb, err := p.Keyboard(key, 0, content)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &telegram.SendMessageRequest{
+		ChatID:    chatID,
+		Text:      content.Text,
+		ParseMode: content.ParseMode,
+		Entities:  content.Entities,
+	}
+
+	// avoid typed nil in the interface
+	if kb != nil {
+		req.ReplyMarkup = kb
+	}
+
+	return p.bot.SendMessage(req)
+}
+
+func (p *Paginator) handleCallback(q *telegram.CallbackQuery) {
+	err := p.showPage(q)
+	if err != nil {
+		log.WithError(err).WithField("data", q.Data).Error("failed to show page")
+	}
+
+	err = answer(p.bot, q)
+	if err != nil {
+		log.WithError(err).Error("failed to answer callback query")
+	}
+}
+
+func (p *Paginator) showPage(q *telegram.CallbackQuery) error {
+	v, err := p.codec.Decode(q.Data)
+	if err != nil {
+		return err
+	}
+	data := v.(*pageData)
+
+	content, err := p.pages(data.Key, data.Page)
+	if err != nil {
+		return err
+	}
+
+	kb, err := p.Keyboard(data.Key, data.Page, content)
+	if err != nil {
+		return err
+	}
+
+	return editMessage(p.bot, q, &telegram.EditMessageTextRequest{
+		Text:        content.Text,
+		ParseMode:   content.ParseMode,
+		Entities:    content.Entities,
+		ReplyMarkup: kb,
+	})
+}
@@ -0,0 +1,139 @@
+package widget
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/callback"
+	"github.com/petuhovskiy/telegram/internal/telegramtest"
+	"github.com/petuhovskiy/telegram/updates"
+)
+
+func buttonTexts(buttons []telegram.InlineKeyboardButton) []string {
+	var res []string
+	for _, b := range buttons {
+		res = append(res, b.Text)
+	}
+	return res
+}
+
+func navigationTexts(t *testing.T, p *Paginator, page, total int) []string {
+	nav, err := p.Navigation("k", page, total)
+	assert.Nil(t, err)
+	return buttonTexts(nav)
+}
+
+func TestPaginator(t *testing.T) {
+	rec := &telegramtest.Recorder{}
+	router := updates.NewRouter()
+	pages := func(key string, page int) (*Page, error) {
+		return &Page{
+			Text:  fmt.Sprintf("%s page %d", key, page+1),
+			Total: 10,
+		}, nil
+	}
+
+	p := NewPaginator(rec.Bot(), router, "list", pages)
+
+	assert.Equal(t, []string{"·1·", "2", "3", "4", "10 »"}, navigationTexts(t, p, 0, 10))
+	assert.Equal(t, []string{"« 1", "4", "·5·", "6", "10 »"}, navigationTexts(t, p, 4, 10))
+	assert.Equal(t, []string{"« 1", "7", "8", "9", "·10·"}, navigationTexts(t, p, 9, 10))
+	assert.Nil(t, navigationTexts(t, p, 0, 1))
+
+	_, err := p.Send("1", "items")
+	assert.Nil(t, err)
+	sent := rec.Last().Req.(*telegram.SendMessageRequest)
+	assert.Equal(t, "items page 1", sent.Text)
+
+	// press "10 »"
+	kb := sent.ReplyMarkup.(*telegram.InlineKeyboardMarkup)
+	last := kb.InlineKeyboard[0][4]
+	rec.Reset()
+	router.HandleUpdate(&telegram.Update{
+		CallbackQuery: &telegram.CallbackQuery{
+			ID:      "q",
+			Message: &telegram.Message{MessageID: 5, Chat: &telegram.Chat{ID: 1}},
+			Data:    last.CallbackData,
+		},
+	})
+
+	reqs := rec.Requests()
+	assert.Len(t, reqs, 2)
+	edit := reqs[0].Req.(*telegram.EditMessageTextRequest)
+	assert.Equal(t, "items page 10", edit.Text)
+	assert.Equal(t, "1", edit.ChatID)
+	assert.Equal(t, 5, edit.MessageID)
+	assert.Equal(t, "answerCallbackQuery", reqs[1].Method)
+}
+
+func TestPaginatorWindow(t *testing.T) {
+	p := NewPaginator(nil, updates.NewRouter(), "list", nil)
+
+	for _, window := range []int{-1, 0, 1, 3} {
+		p.Window = window
+		assert.Equal(t, []string{"« 1", "·5·", "10 »"}, navigationTexts(t, p, 4, 10))
+		assert.Equal(t, []string{"·1·", "2", "10 »"}, navigationTexts(t, p, 0, 10))
+		assert.Equal(t, []string{"1", "·2·"}, navigationTexts(t, p, 1, 2))
+	}
+}
+
+func TestPaginatorLongKey(t *testing.T) {
+	router := updates.NewRouter()
+	pages := func(key string, page int) (*Page, error) {
+		return &Page{Text: key, Total: 2}, nil
+	}
+	p := NewPaginator((&telegramtest.Recorder{}).Bot(), router, "list", pages)
+
+	key := strings.Repeat("k", 100)
+	_, err := p.Navigation(key, 0, 2)
+	assert.True(t, errors.Is(err, callback.ErrTooLong))
+	_, err = p.Send("1", key)
+	assert.True(t, errors.Is(err, callback.ErrTooLong))
+
+	storage := callback.NewStorage(callback.NewMemoryStore(10), time.Hour)
+	router.SetStorage(storage)
+	p.SetStorage(storage)
+
+	nav, err := p.Navigation(key, 0, 2)
+	assert.Nil(t, err)
+
+	q := &telegram.CallbackQuery{
+		ID:      "q",
+		Message: &telegram.Message{MessageID: 5, Chat: &telegram.Chat{ID: 1}},
+		Data:    nav[1].CallbackData,
+	}
+	assert.Nil(t, p.showPage(q))
+}
+
+func TestPaginatorNotModified(t *testing.T) {
+	rec := &telegramtest.Recorder{
+		Handler: func(method string, req interface{}) (json.RawMessage, error) {
+			if method == "editMessageText" {
+				return nil, telegram.ErrorResponse{ErrorCode: 400, Description: "Bad Request: message is not modified"}
+			}
+			return telegramtest.DefaultResponse(method, req)
+		},
+	}
+
+	router := updates.NewRouter()
+	p := NewPaginator(rec.Bot(), router, "list", func(key string, page int) (*Page, error) {
+		return &Page{Text: "page", Total: 2}, nil
+	})
+
+	nav, err := p.Navigation("k", 0, 2)
+	assert.Nil(t, err)
+
+	q := &telegram.CallbackQuery{
+		ID:      "q",
+		Message: &telegram.Message{MessageID: 5, Chat: &telegram.Chat{ID: 1}},
+		Data:    nav[0].CallbackData,
+	}
+	assert.Nil(t, p.showPage(q))
+}
@@ -0,0 +1,178 @@
+package widget
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/petuhovskiy/telegram"
+	"github.com/petuhovskiy/telegram/callback"
+	"github.com/petuhovskiy/telegram/updates"
+)
+
+const (
+	actionHourUp     = "h+"
+	actionHourDown   = "h-"
+	actionMinuteUp   = "m+"
+	actionMinuteDown = "m-"
+	actionDone       = "ok"
+)
+
+// timeData is the callback data, date is encoded as yyyymmdd.
+type timeData struct {
+	Action  string
+	Date    int
+	Minutes int // minutes since midnight
+}
+
+// TimePicker shows hours and minutes, which are changed by arrows.
+type TimePicker struct {
+	bot      *telegram.Bot
+	codec    *callback.Codec
+	onSelect func(q *telegram.CallbackQuery, t time.Time)
+
+	Location *time.Location
+
+	// Step is the change of minutes, one minute by default.
+	Step time.Duration
+
+	DoneText string
+}
+
+// NewTimePicker registers callback handler of the time picker in the router.
+// Prefix must be unique for the router.
+func NewTimePicker(bot *telegram.Bot, router *updates.Router, prefix string, onSelect func(q *telegram.CallbackQuery, t time.Time)) *TimePicker {
+	codec := callback.NewCodec()
+	codec.MustRegister(prefix, timeData{})
+
+	p := &TimePicker{
+		bot:      bot,
+		codec:    codec,
+		onSelect: onSelect,
+		Location: time.UTC,
+		Step:     time.Minute,
+		DoneText: "OK",
+	}
+
+	router.HandleCallback(prefix, p.handleCallback)
+	return p
+}
+
+func (p *TimePicker) button(text string, action string, date, minutes int) (telegram.InlineKeyboardButton, error) {
+	return p.codec.Button(text, timeData{Action: action, Date: date, Minutes: minutes})
+}
+
+func (p *TimePicker) step() int {
+	step := int(p.Step / time.Minute)
+	if step < 1 {
+		step = 1
+	}
+	return step
+}
+
+// Keyboard returns picker with the time of t selected. callback.ErrTooLong
+// is returned when the prefix is too long for the callback data.
+func (p *TimePicker) Keyboard(t time.Time) (*telegram.InlineKeyboardMarkup, error) {
+	t = t.In(p.Location)
+	date := dateKey(t)
+	minutes := t.Hour()*60 + t.Minute()
+	minutes -= minutes % p.step()
+
+	return p.keyboard(date, minutes)
+}
+
+func (p *TimePicker) keyboard(date, minutes int) (*telegram.InlineKeyboardMarkup, error) {
+	hour, minute := minutes/60, minutes%60
+
+	rows := [][]struct {
+		text   string
+		action string
+	}{
+		{{"▲", actionHourUp}, {"▲", actionMinuteUp}},
+		{{fmt.Sprintf("%02d", hour), actionNoop}, {fmt.Sprintf("%02d", minute), actionNoop}},
+		{{"▼", actionHourDown}, {"▼", actionMinuteDown}},
+		{{p.DoneText, actionDone}},
+	}
+
+	var keyboard [][]telegram.InlineKeyboardButton
+	for _, row := range rows {
+		var buttons []telegram.InlineKeyboardButton
+		for _, b := range row {
+			button, err := p.button(b.text, b.action, date, minutes)
+			if err != nil {
+				return nil, err
+			}
+			buttons = append(buttons, button)
+		}
+		keyboard = append(keyboard, buttons)
+	}
+
+	return &telegram.InlineKeyboardMarkup{
+		InlineKeyboard: keyboard,
+	}, nil
+}
+
+// Send sends the message with time picker, the date of t is kept in the result.
+func (p *TimePicker) Send(chatID string, text string, t time.Time) (*telegram.Message, error) {
+	kb, err := p.Keyboard(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.bot.SendMessage(&telegram.SendMessageRequest{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: kb,
+	})
+}
+
+func (p *TimePicker) handleCallback(q *telegram.CallbackQuery) {
+	err := p.press(q)
+	if err != nil {
+		log.WithError(err).WithField("data", q.Data).Error("failed to handle time picker button")
+	}
+
+	err = answer(p.bot, q)
+	if err != nil {
+		log.WithError(err).Error("failed to answer callback query")
+	}
+}
+
+// wrapValue returns value in range [0, n).
+func wrapValue(v, n int) int {
+	return (v%n + n) % n
+}
+
+func (p *TimePicker) press(q *telegram.CallbackQuery) error {
+	v, err := p.codec.Decode(q.Data)
+	if err != nil {
+		return err
+	}
+	data := v.(*timeData)
+
+	hour, minute := data.Minutes/60, data.Minutes%60
+	switch data.Action {
+	case actionHourUp:
+		hour = wrapValue(hour+1, 24)
+	case actionHourDown:
+		hour = wrapValue(hour-1, 24)
+	case actionMinuteUp:
+		minute = wrapValue(minute+p.step(), 60)
+	case actionMinuteDown:
+		minute = wrapValue(minute-p.step(), 60)
+	case actionDone:
+		date := keyDate(data.Date, p.Location)
+		p.onSelect(q, time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, p.Location))
+		return nil
+	default:
+		return nil
+	}
+
+	kb, err := p.keyboard(data.Date, hour*60+minute)
+	if err != nil {
+		return err
+	}
+
+	return editKeyboard(p.bot, q, kb)
+}